@@ -0,0 +1,35 @@
+package connector
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// ErrorClassifier determines whether an error returned while opening a connection was
+// caused by the credential being rejected, in which case the credential is refreshed and
+// the connection is retried.
+type ErrorClassifier func(err error) bool
+
+// MySQL server error numbers which indicate that the credential should be refreshed.
+const (
+	mysqlAccessDenied           = 1045
+	mysqlAccessDeniedNoPassword = 1698
+	mysqlMustChangePassword     = 1820
+	mysqlPasswordExpired        = 1862
+)
+
+// IsMySQLAuthenticationError is the default ErrorClassifier. It returns true if the error
+// is, or wraps, a *mysql.MySQLError for access denied (1045, 1698), a password which must
+// be reset (1820) or an expired password (1862).
+func IsMySQLAuthenticationError(err error) bool {
+	var me *mysql.MySQLError
+	if !errors.As(err, &me) {
+		return false
+	}
+	switch me.Number {
+	case mysqlAccessDenied, mysqlAccessDeniedNoPassword, mysqlMustChangePassword, mysqlPasswordExpired:
+		return true
+	}
+	return false
+}
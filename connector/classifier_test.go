@@ -0,0 +1,68 @@
+package connector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestIsMySQLAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil errors are not authentication errors",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "errors which aren't MySQL errors are ignored, even if they contain the text",
+			err:      errors.New("Error 1045: Access denied"),
+			expected: false,
+		},
+		{
+			name:     "access denied",
+			err:      &mysql.MySQLError{Number: 1045},
+			expected: true,
+		},
+		{
+			name:     "access denied, no password",
+			err:      &mysql.MySQLError{Number: 1698},
+			expected: true,
+		},
+		{
+			name:     "must reset password",
+			err:      &mysql.MySQLError{Number: 1820},
+			expected: true,
+		},
+		{
+			name:     "password expired",
+			err:      &mysql.MySQLError{Number: 1862},
+			expected: true,
+		},
+		{
+			name:     "wrapped errors are detected",
+			err:      fmt.Errorf("failed to connect: %w", &mysql.MySQLError{Number: 1045}),
+			expected: true,
+		},
+		{
+			name:     "other MySQL errors are not authentication errors",
+			err:      &mysql.MySQLError{Number: 1049},
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			actual := IsMySQLAuthenticationError(test.err)
+			if actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
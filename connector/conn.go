@@ -0,0 +1,163 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+)
+
+// conn wraps a connection to record the credential it was opened with, so that it can be
+// retired from the database/sql pool once the store has a newer credential.
+type conn struct {
+	driver.Conn
+	c    *Connector
+	cred credential
+}
+
+var (
+	_ driver.Conn               = &conn{}
+	_ driver.ConnBeginTx        = &conn{}
+	_ driver.ConnPrepareContext = &conn{}
+	_ driver.ExecerContext      = &conn{}
+	_ driver.QueryerContext     = &conn{}
+	_ driver.Pinger             = &conn{}
+	_ driver.NamedValueChecker  = &conn{}
+	_ driver.SessionResetter    = &conn{}
+	_ driver.Validator          = &conn{}
+)
+
+// open a connection with the credential, wrapping it to record the credential.
+func (c *Connector) open(ctx context.Context, cred credential) (driver.Conn, error) {
+	dc, err := c.openCredential(ctx, cred)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: dc, c: c, cred: cred}, nil
+}
+
+// CachedCredentialStore is a CredentialStore which can return its cached credential
+// without retrieving it. The connector uses it to retire pooled connections as soon as the
+// store's credential changes, e.g. after a background refresh. store.Secret and store.RDS
+// implement it.
+type CachedCredentialStore interface {
+	CredentialStore
+	// Cached returns the cached credential, or false if there isn't one. It must not
+	// retrieve the credential.
+	Cached() (credential string, ok bool)
+}
+
+// CachedCredentialsStore is a CredentialsStore which can return its cached credentials
+// without retrieving them. See CachedCredentialStore. store.RDS implements it.
+type CachedCredentialsStore interface {
+	CredentialsStore
+	// CachedCredentials returns the cached credentials, or false if there aren't any. It
+	// must not retrieve the credentials.
+	CachedCredentials() (c store.Credentials, ok bool)
+}
+
+// superseded returns true if the latest credential for the stage the connector is using
+// differs from the credential the connection was opened with, e.g. because the secret was
+// rotated. It's called each time database/sql returns a connection to the pool, so it
+// never retrieves the credential. The store's cached credential is used if the store
+// implements CachedCredentialStore or CachedCredentialsStore, otherwise the credential the
+// connector last retrieved for the stage.
+func (cn *conn) superseded() bool {
+	stage := cn.c.stage()
+	var latest credential
+	var ok bool
+	if stage == StageCurrent {
+		latest, ok = cn.c.src.peek()
+	}
+	if !ok {
+		latest, ok = cn.c.lastSeen(stage)
+	}
+	if !ok || latest == cn.cred {
+		return false
+	}
+	cn.c.observe(ConnectionRetired{})
+	return true
+}
+
+// Unwrap returns the driver's connection.
+func (cn *conn) Unwrap() driver.Conn {
+	return cn.Conn
+}
+
+// ResetSession implements driver.SessionResetter. It's called by database/sql before a
+// connection is reused, and returns driver.ErrBadConn if the connection was opened with a
+// superseded credential, so that the pool discards it.
+func (cn *conn) ResetSession(ctx context.Context) error {
+	if cn.superseded() {
+		return driver.ErrBadConn
+	}
+	if sr, ok := cn.Conn.(driver.SessionResetter); ok {
+		return sr.ResetSession(ctx)
+	}
+	return nil
+}
+
+// IsValid implements driver.Validator. It returns false if the connection was opened with
+// a superseded credential, so that database/sql closes it instead of returning it to the
+// pool.
+func (cn *conn) IsValid() bool {
+	if cn.superseded() {
+		return false
+	}
+	if v, ok := cn.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+// BeginTx implements driver.ConnBeginTx.
+func (cn *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if bt, ok := cn.Conn.(driver.ConnBeginTx); ok {
+		return bt.BeginTx(ctx, opts)
+	}
+	if opts.Isolation != 0 || opts.ReadOnly {
+		return nil, errors.New("connector: driver does not support non-default transaction options")
+	}
+	return cn.Conn.Begin()
+}
+
+// PrepareContext implements driver.ConnPrepareContext.
+func (cn *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if pc, ok := cn.Conn.(driver.ConnPrepareContext); ok {
+		return pc.PrepareContext(ctx, query)
+	}
+	return cn.Conn.Prepare(query)
+}
+
+// ExecContext implements driver.ExecerContext.
+func (cn *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if ec, ok := cn.Conn.(driver.ExecerContext); ok {
+		return ec.ExecContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+// QueryContext implements driver.QueryerContext.
+func (cn *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if qc, ok := cn.Conn.(driver.QueryerContext); ok {
+		return qc.QueryContext(ctx, query, args)
+	}
+	return nil, driver.ErrSkip
+}
+
+// Ping implements driver.Pinger.
+func (cn *conn) Ping(ctx context.Context) error {
+	if p, ok := cn.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// CheckNamedValue implements driver.NamedValueChecker.
+func (cn *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if nvc, ok := cn.Conn.(driver.NamedValueChecker); ok {
+		return nvc.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
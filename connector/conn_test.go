@@ -0,0 +1,259 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestConnectionsAreRetiredWhenTheCredentialChanges(t *testing.T) {
+	s := &switchableStore{dsn: "first"}
+	d := &poolDriver{}
+	db := sql.OpenDB(New(s, WithDriver(d)))
+	defer db.Close()
+	db.SetMaxIdleConns(1)
+	db.SetMaxOpenConns(1)
+
+	for i := 0; i < 2; i++ {
+		if _, err := db.Exec("SELECT 1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if opened := d.opened(); len(opened) != 1 {
+		t.Fatalf("expected the pooled connection to be reused, got %v", opened)
+	}
+
+	s.set("second")
+	if _, err := db.Exec("SELECT 1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"first", "second"}
+	if opened := d.opened(); len(opened) != 2 || opened[1] != "second" {
+		t.Fatalf("expected connections %v, got %v", expected, opened)
+	}
+	if closed := d.closed(); len(closed) != 1 || closed[0] != "first" {
+		t.Errorf("expected the connection opened with the first credential to be closed, got %v", closed)
+	}
+}
+
+func TestReturningConnectionsDoesNotGetTheCredential(t *testing.T) {
+	s := &switchableStore{dsn: "first"}
+	db := sql.OpenDB(New(s, WithDriver(&poolDriver{})))
+	defer db.Close()
+	db.SetMaxIdleConns(1)
+	db.SetMaxOpenConns(1)
+
+	for i := 0; i < 5; i++ {
+		if _, err := db.Exec("SELECT 1"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if gets := s.getCalls(); gets != 1 {
+		t.Errorf("expected the credential to be retrieved once, when connecting, got %d", gets)
+	}
+}
+
+func TestConnectionsAreRetiredWhenAnUncachedStoreChanges(t *testing.T) {
+	s := &switchableStore{dsn: "first"}
+	d := &poolDriver{}
+	c := New(uncachedStore{s: s}, WithDriver(d))
+	first, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.set("second")
+	// The connector doesn't know the credential has changed until it retrieves it.
+	if !first.(driver.Validator).IsValid() {
+		t.Error("expected the connection to be valid")
+	}
+	if _, err = c.Connect(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.(driver.Validator).IsValid() {
+		t.Error("expected the connection opened with the first credential to be retired")
+	}
+	if gets := s.getCalls(); gets != 2 {
+		t.Errorf("expected the credential to be retrieved by each connection, got %d", gets)
+	}
+}
+
+func TestConnResetSession(t *testing.T) {
+	resetErr := errors.New("reset failed")
+	tests := []struct {
+		name          string
+		rotate        bool
+		resetErr      error
+		expectedErr   error
+		expectedValid bool
+	}{
+		{
+			name:          "connections with the current credential are kept",
+			expectedValid: true,
+		},
+		{
+			name:        "connections with a superseded credential are discarded",
+			rotate:      true,
+			expectedErr: driver.ErrBadConn,
+		},
+		{
+			name:          "the driver's connection is reset",
+			resetErr:      resetErr,
+			expectedErr:   resetErr,
+			expectedValid: true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			s := &switchableStore{dsn: "first"}
+			c := New(s, WithDriver(&poolDriver{resetErr: test.resetErr}))
+			dc, err := c.Connect(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.rotate {
+				s.set("second")
+			}
+			if err = dc.(driver.SessionResetter).ResetSession(context.Background()); err != test.expectedErr {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if valid := dc.(driver.Validator).IsValid(); valid != test.expectedValid {
+				t.Errorf("expected IsValid to be %v, got %v", test.expectedValid, valid)
+			}
+		})
+	}
+}
+
+func TestConnUnsupportedMethodsAreSkipped(t *testing.T) {
+	c := New(constantStore("dsn"), WithDriver(&mockDriver{
+		GetResults: []DriverGetResult{
+			{
+				Conn: minimalConn{},
+			},
+		},
+	}))
+	dc, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = dc.(driver.ExecerContext).ExecContext(context.Background(), "SELECT 1", nil); err != driver.ErrSkip {
+		t.Errorf("expected ErrSkip from ExecContext, got %v", err)
+	}
+	if _, err = dc.(driver.QueryerContext).QueryContext(context.Background(), "SELECT 1", nil); err != driver.ErrSkip {
+		t.Errorf("expected ErrSkip from QueryContext, got %v", err)
+	}
+	if _, err = dc.(driver.ConnBeginTx).BeginTx(context.Background(), driver.TxOptions{ReadOnly: true}); err == nil {
+		t.Error("expected an error for unsupported transaction options")
+	}
+	if dc.(interface{ Unwrap() driver.Conn }).Unwrap() != (minimalConn{}) {
+		t.Error("expected Unwrap to return the driver's connection")
+	}
+}
+
+// switchableStore returns the DSN it was last set to, and counts calls to Get.
+type switchableStore struct {
+	m    sync.Mutex
+	dsn  string
+	gets int
+}
+
+func (s *switchableStore) Get(force bool) (credential string, err error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.gets++
+	return s.dsn, nil
+}
+
+func (s *switchableStore) Cached() (credential string, ok bool) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.dsn, true
+}
+
+func (s *switchableStore) getCalls() int {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.gets
+}
+
+// uncachedStore is a switchableStore which can't return its cached credential.
+type uncachedStore struct {
+	s *switchableStore
+}
+
+func (s uncachedStore) Get(force bool) (credential string, err error) {
+	return s.s.Get(force)
+}
+
+func (s *switchableStore) set(dsn string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.dsn = dsn
+}
+
+// poolDriver records the DSNs of the connections opened and closed.
+type poolDriver struct {
+	m          sync.Mutex
+	resetErr   error
+	openDSNs   []string
+	closedDSNs []string
+}
+
+func (d *poolDriver) Open(dsn string) (driver.Conn, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.openDSNs = append(d.openDSNs, dsn)
+	return &poolConn{d: d, dsn: dsn}, nil
+}
+
+func (d *poolDriver) opened() []string {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return append([]string{}, d.openDSNs...)
+}
+
+func (d *poolDriver) closed() []string {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return append([]string{}, d.closedDSNs...)
+}
+
+type poolConn struct {
+	minimalConn
+	d   *poolDriver
+	dsn string
+}
+
+func (c *poolConn) Close() error {
+	c.d.m.Lock()
+	defer c.d.m.Unlock()
+	c.d.closedDSNs = append(c.d.closedDSNs, c.dsn)
+	return nil
+}
+
+func (c *poolConn) ResetSession(ctx context.Context) error {
+	return c.d.resetErr
+}
+
+func (c *poolConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+// minimalConn only implements driver.Conn.
+type minimalConn struct{}
+
+func (minimalConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (minimalConn) Close() error {
+	return nil
+}
+
+func (minimalConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not implemented")
+}
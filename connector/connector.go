@@ -3,10 +3,11 @@ package connector
 import (
 	"context"
 	"database/sql/driver"
-	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // CredentialStore is how credentials can be retrieved.
@@ -14,48 +15,222 @@ type CredentialStore interface {
 	Get(force bool) (credential string, err error)
 }
 
-// New connector.
-func New(store CredentialStore) *Connector {
-	return &Connector{
-		store: store,
-		d:     defaultDriver,
-		m:     &sync.Mutex{},
+// ContextCredentialStore is a CredentialStore which can abandon retrieval when the context
+// is cancelled or its deadline is exceeded. The connector prefers GetContext when the store
+// implements it.
+type ContextCredentialStore interface {
+	CredentialStore
+	GetContext(ctx context.Context, force bool) (credential string, err error)
+}
+
+// Option configures the Connector.
+type Option func(c *Connector)
+
+// WithDriver sets the database/sql driver used to open connections with the DSN returned by
+// the store. If the driver implements driver.DriverContext, connections are opened with the
+// context passed to Connect. Defaults to the MySQL driver. Use WithErrorClassifier to
+// recognise the driver's authentication errors.
+func WithDriver(d driver.Driver) Option {
+	return func(c *Connector) {
+		c.d = func() driver.Driver { return d }
+	}
+}
+
+// WithErrorClassifier sets the function used to determine whether a failure to open a
+// connection was caused by the credential being rejected. Defaults to
+// IsMySQLAuthenticationError.
+func WithErrorClassifier(ec ErrorClassifier) Option {
+	return func(c *Connector) {
+		c.isAuthErr = ec
+	}
+}
+
+// New connector, which opens connections by passing the DSN returned by the store to
+// the driver.
+func New(store CredentialStore, opts ...Option) *Connector {
+	c := newConnector(dsnSource{store: store}, opts...)
+	c.openCredential = c.openDSN
+	return c
+}
+
+func newConnector(src source, opts ...Option) *Connector {
+	c := &Connector{
+		src:            src,
+		d:              defaultDriver,
+		isAuthErr:      IsMySQLAuthenticationError,
+		tracer:         defaultTracer(),
+		fallbackStages: []string{StagePrevious},
+		m:              &sync.Mutex{},
+		lastStage:      StageCurrent,
+		seen:           make(map[string]credential),
+	}
+	for _, o := range opts {
+		o(c)
 	}
+	return c
 }
 
 func defaultDriver() driver.Driver {
 	return mysql.MySQLDriver{}
 }
 
-// Connector to MySQL.
+// Connector to a database.
 type Connector struct {
-	store CredentialStore
-	d     func() driver.Driver
-	m     *sync.Mutex
+	src            source
+	openCredential func(ctx context.Context, cred credential) (driver.Conn, error)
+	d              func() driver.Driver
+	isAuthErr      ErrorClassifier
+	fallbackStages []string
+	observers      []Observer
+	tracer         trace.Tracer
+	// m protects refreshing, lastStage and seen.
+	m          *sync.Mutex
+	refreshing *refresh
+	lastStage  string
+	// seen is the credential last retrieved for each stage.
+	seen map[string]credential
+}
+
+// refresh is a forced credential refresh which is shared by all of the connection attempts
+// that fail authentication while it's in progress.
+type refresh struct {
+	done       chan struct{}
+	credential credential
+	err        error
 }
 
 // Connect implements driver.Connector interface.
-// Connect returns a connection to the database.
+// Connect returns a connection to the database. Connections are opened concurrently, only
+// forced refreshes of the credential are coordinated between callers.
+//
+// If the credential is rejected, the current credential is refreshed and, if it changed,
+// the connection retried. If that's also rejected, or didn't change, and the store
+// supports staging labels, the fallback stages are tried.
+//
+// Connections record the credential they were opened with. When database/sql resets or
+// validates a pooled connection, it's discarded if the store's cached credential has
+// changed since, e.g. after a rotation, so that the pool drains gracefully.
 func (c *Connector) Connect(ctx context.Context) (conn driver.Conn, err error) {
-	c.m.Lock()
-	defer c.m.Unlock()
-	creds, err := c.store.Get(false)
+	stage := c.stage()
+	ctx, span := c.tracer.Start(ctx, "connector.Connect", trace.WithAttributes(attrStage.String(stage)))
+	defer func() { endSpan(span, err) }()
+	creds, err := c.getCredential(ctx, stage, false)
 	if err != nil {
 		return
 	}
-	conn, err = c.Driver().Open(creds)
-	if err != nil && strings.Contains(err.Error(), "Error 1045") {
-		creds, err = c.store.Get(true)
+	conn, err = c.openStage(ctx, stage, false, creds)
+	if err == nil || !c.isAuthErr(err) {
+		return
+	}
+	c.observe(AuthFailed{Stage: stage, Err: err})
+	rejected, authErr := creds, err
+	creds, err = c.refresh(ctx, rejected)
+	if err != nil {
+		return
+	}
+	if creds == rejected {
+		// Retrying with the same credential would fail in the same way.
+		return c.openFallback(ctx, stage, authErr)
+	}
+	conn, err = c.openStage(ctx, StageCurrent, true, creds)
+	c.observe(Retried{Stage: StageCurrent, Err: err})
+	if err == nil {
+		c.setStage(StageCurrent)
+		return
+	}
+	if !c.isAuthErr(err) {
+		return
+	}
+	c.observe(AuthFailed{Stage: StageCurrent, Err: err})
+	return c.openFallback(ctx, stage, err)
+}
+
+// refreshTimeout limits how long a forced refresh can take. The refresh is shared between
+// callers, so it doesn't use any one caller's context.
+const refreshTimeout = time.Second * 30
+
+// refresh forces the store to reload the rejected credential. If a refresh is already in
+// progress, the caller waits for its result instead of starting another one. The refresh
+// runs until it completes, or the refresh timeout expires, even if the caller that started
+// it gives up waiting, so that other callers don't fail with its context's error.
+func (c *Connector) refresh(ctx context.Context, rejected credential) (cred credential, err error) {
+	ctx, span := c.tracer.Start(ctx, "connector.Refresh")
+	defer func() {
+		span.SetAttributes(attrChanged.Bool(err == nil && cred != rejected))
+		endSpan(span, err)
+	}()
+	c.m.Lock()
+	r := c.refreshing
+	if r == nil {
+		r = &refresh{
+			done: make(chan struct{}),
+		}
+		c.refreshing = r
+		go c.runRefresh(context.WithoutCancel(ctx), r, rejected)
+	}
+	c.m.Unlock()
+	select {
+	case <-r.done:
+		return r.credential, r.err
+	case <-ctx.Done():
+		return credential{}, ctx.Err()
+	}
+}
+
+// runRefresh gets the credential for the shared refresh, and notifies the callers waiting
+// for it.
+func (c *Connector) runRefresh(ctx context.Context, r *refresh, rejected credential) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+	start := time.Now()
+	r.credential, r.err = c.getCredential(ctx, StageCurrent, true)
+	c.observe(RefreshForced{Changed: r.err == nil && r.credential != rejected, Duration: time.Since(start), Err: r.err})
+
+	c.m.Lock()
+	c.refreshing = nil
+	c.m.Unlock()
+	close(r.done)
+}
+
+// getCredential gets the credential for the stage from the store.
+func (c *Connector) getCredential(ctx context.Context, stage string, force bool) (cred credential, err error) {
+	ctx, span := c.tracer.Start(ctx, "connector.GetCredential",
+		trace.WithAttributes(attrStage.String(stage), attrForced.Bool(force)))
+	defer func() { endSpan(span, err) }()
+	cred, err = c.src.get(ctx, stage, force)
+	if err == nil {
+		c.setLastSeen(stage, cred)
+	}
+	return
+}
+
+// openStage opens a connection with the credential for the stage. retry is true if the
+// connection is being retried after the credential was rejected.
+func (c *Connector) openStage(ctx context.Context, stage string, retry bool, cred credential) (conn driver.Conn, err error) {
+	ctx, span := c.tracer.Start(ctx, "connector.Open",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrStage.String(stage), attrRetry.Bool(retry)))
+	defer func() { endSpan(span, err) }()
+	return c.open(ctx, cred)
+}
+
+// openDSN opens a connection by passing the credential's DSN to the driver.
+func (c *Connector) openDSN(ctx context.Context, cred credential) (conn driver.Conn, err error) {
+	dsn := cred.dsn
+	d := c.Driver()
+	if dctx, ok := d.(driver.DriverContext); ok {
+		var dc driver.Connector
+		dc, err = dctx.OpenConnector(dsn)
 		if err != nil {
 			return
 		}
-		conn, err = c.Driver().Open(creds)
+		return dc.Connect(ctx)
 	}
-	return
+	return d.Open(dsn)
 }
 
 // Driver implements driver.Connector interface.
-// Driver returns &MySQLDriver{}.
+// Driver returns the driver set by WithDriver, or mysql.MySQLDriver{} by default.
 func (c *Connector) Driver() driver.Driver {
 	return c.d()
 }
@@ -0,0 +1,42 @@
+package connector
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithLogger logs authentication failures, forced refreshes and retries. Authentication
+// failures and failed retries are logged at warn level, and failed refreshes at error
+// level. Credentials are never logged.
+func WithLogger(l *slog.Logger) Option {
+	return WithObserver(logger{l: l})
+}
+
+// logger is an Observer which logs events.
+type logger struct {
+	l *slog.Logger
+}
+
+func (l logger) Observe(e Event) {
+	ctx := context.Background()
+	switch e := e.(type) {
+	case AuthFailed:
+		l.l.LogAttrs(ctx, slog.LevelWarn, "connector: credential rejected", slog.String("stage", e.Stage), slog.Any("err", e.Err))
+	case RefreshForced:
+		if e.Err != nil {
+			l.l.LogAttrs(ctx, slog.LevelError, "connector: failed to refresh credential",
+				slog.Duration("duration", e.Duration), slog.Any("err", e.Err))
+			return
+		}
+		l.l.LogAttrs(ctx, slog.LevelInfo, "connector: refreshed credential",
+			slog.Bool("changed", e.Changed), slog.Duration("duration", e.Duration))
+	case Retried:
+		if e.Err != nil {
+			l.l.LogAttrs(ctx, slog.LevelWarn, "connector: retry failed", slog.String("stage", e.Stage), slog.Any("err", e.Err))
+			return
+		}
+		l.l.LogAttrs(ctx, slog.LevelInfo, "connector: retry succeeded", slog.String("stage", e.Stage))
+	case ConnectionRetired:
+		l.l.LogAttrs(ctx, slog.LevelDebug, "connector: retired connection opened with a superseded credential")
+	}
+}
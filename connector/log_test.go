@@ -0,0 +1,39 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+	buf := new(bytes.Buffer)
+	l := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "duration" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	c := New(stagedStore{}, WithDriver(&stagedDriver{valid: "previous"}), WithLogger(l))
+	if _, err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authErr := &mysql.MySQLError{Number: 1045, Message: "Access denied"}
+	expected := []string{
+		`level=WARN msg="connector: credential rejected" stage=AWSCURRENT err="` + authErr.Error() + `"`,
+		`level=INFO msg="connector: refreshed credential" changed=false`,
+		`level=INFO msg="connector: retry succeeded" stage=AWSPREVIOUS`,
+	}
+	actual := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if strings.Join(actual, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(actual, "\n"))
+	}
+}
@@ -0,0 +1,83 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+	"github.com/go-sql-driver/mysql"
+)
+
+// CredentialsStore is how structured credentials can be retrieved.
+type CredentialsStore interface {
+	Credentials(ctx context.Context, force bool) (c store.Credentials, err error)
+}
+
+// StagedCredentialsStore is a CredentialsStore which can retrieve versions of the
+// credentials other than the current one. See StagedCredentialStore.
+type StagedCredentialsStore interface {
+	CredentialsStore
+	CredentialsStage(ctx context.Context, stage string, force bool) (c store.Credentials, err error)
+}
+
+type credentialsKey struct{}
+
+// NewMySQL creates a connector which opens connections using the configuration, e.g. as
+// created by mysql.NewConfig. Immediately before each connection is opened, the user,
+// password and address are set from the store's credentials, as is the database name, if
+// the configuration doesn't set it. The password is never formatted into a DSN.
+// If the configuration's TLS config doesn't set a ServerName, the server's certificate is
+// verified against the host of the credentials.
+func NewMySQL(s CredentialsStore, cfg *mysql.Config, opts ...Option) (c *Connector, err error) {
+	cfg = cfg.Clone()
+	apply := applyCredentials
+	if cfg.TLS != nil && cfg.TLS.ServerName == "" && !cfg.TLS.InsecureSkipVerify {
+		apply = applyCredentialsAndServerName
+	}
+	if err = cfg.Apply(mysql.BeforeConnect(apply)); err != nil {
+		return
+	}
+	mc, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return
+	}
+	c = newConnector(credentialsSource{store: s}, opts...)
+	c.openCredential = func(ctx context.Context, cred credential) (driver.Conn, error) {
+		return mc.Connect(context.WithValue(ctx, credentialsKey{}, cred.creds))
+	}
+	return
+}
+
+// applyCredentials from the context to the configuration of the connection being opened.
+func applyCredentials(ctx context.Context, cfg *mysql.Config) error {
+	creds, ok := ctx.Value(credentialsKey{}).(store.Credentials)
+	if !ok {
+		return errors.New("connector: credentials missing from context")
+	}
+	cfg.User = creds.Username
+	cfg.Passwd = creds.Password
+	if cfg.Net == "" {
+		cfg.Net = "tcp"
+	}
+	cfg.Addr = creds.Addr()
+	if cfg.DBName == "" {
+		cfg.DBName = creds.DBName
+	}
+	return nil
+}
+
+// applyCredentialsAndServerName applies the credentials, and verifies the server's
+// certificate against the host, rather than the address the connector was created with.
+func applyCredentialsAndServerName(ctx context.Context, cfg *mysql.Config) error {
+	if err := applyCredentials(ctx, cfg); err != nil {
+		return err
+	}
+	host, _, err := net.SplitHostPort(cfg.Addr)
+	if err != nil {
+		host = cfg.Addr
+	}
+	cfg.TLS.ServerName = host
+	return nil
+}
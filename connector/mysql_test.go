@@ -0,0 +1,112 @@
+package connector
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestApplyCredentials(t *testing.T) {
+	creds := store.Credentials{
+		Username: "user",
+		Password: "pwd",
+		Host:     "host_name",
+		Port:     3306,
+		DBName:   "secretDB",
+	}
+	tests := []struct {
+		name           string
+		dbName         string
+		expectedDBName string
+	}{
+		{
+			name:           "the database name is taken from the credentials if not configured",
+			expectedDBName: "secretDB",
+		},
+		{
+			name:           "the configured database name takes precedence",
+			dbName:         "configuredDB",
+			expectedDBName: "configuredDB",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cfg := mysql.NewConfig()
+			cfg.DBName = test.dbName
+			ctx := context.WithValue(context.Background(), credentialsKey{}, creds)
+			if err := applyCredentials(ctx, cfg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.User != "user" || cfg.Passwd != "pwd" {
+				t.Errorf("expected user and password to be set, got %q, %q", cfg.User, cfg.Passwd)
+			}
+			if cfg.Net != "tcp" || cfg.Addr != "host_name:3306" {
+				t.Errorf("expected tcp(host_name:3306), got %s(%s)", cfg.Net, cfg.Addr)
+			}
+			if cfg.DBName != test.expectedDBName {
+				t.Errorf("expected database name %q, got %q", test.expectedDBName, cfg.DBName)
+			}
+		})
+	}
+}
+
+func TestApplyCredentialsRequiresCredentials(t *testing.T) {
+	if err := applyCredentials(context.Background(), mysql.NewConfig()); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestApplyCredentialsAndServerName(t *testing.T) {
+	cfg := mysql.NewConfig()
+	cfg.TLS = &tls.Config{ServerName: "placeholder"}
+	ctx := context.WithValue(context.Background(), credentialsKey{}, store.Credentials{
+		Host: "host_name",
+		Port: 3306,
+	})
+	if err := applyCredentialsAndServerName(ctx, cfg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.TLS.ServerName != "host_name" {
+		t.Errorf("expected the server name to be the host, got %q", cfg.TLS.ServerName)
+	}
+}
+
+func TestNewMySQL(t *testing.T) {
+	dialErr := errors.New("dial failed")
+	var dialled []string
+	mysql.RegisterDialContext("connectortest", func(ctx context.Context, addr string) (net.Conn, error) {
+		dialled = append(dialled, addr)
+		return nil, dialErr
+	})
+	cfg := mysql.NewConfig()
+	cfg.Net = "connectortest"
+	// Replaced by the credentials before connecting.
+	cfg.Addr = "placeholder:0"
+	c, err := NewMySQL(credentialsStore{
+		Host: "host_name",
+		Port: 3306,
+	}, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = c.Connect(context.Background())
+	if !errors.Is(err, dialErr) {
+		t.Errorf("expected the dial error, got: %v", err)
+	}
+	if len(dialled) != 1 || dialled[0] != "host_name:3306" {
+		t.Errorf("expected to dial host_name:3306, got %v", dialled)
+	}
+}
+
+type credentialsStore store.Credentials
+
+func (cs credentialsStore) Credentials(ctx context.Context, force bool) (c store.Credentials, err error) {
+	return store.Credentials(cs), nil
+}
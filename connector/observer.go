@@ -0,0 +1,68 @@
+package connector
+
+import "time"
+
+// Observer receives events from a Connector, e.g. to record metrics. Observers are called
+// synchronously, from any goroutine, so must be safe for concurrent use and return quickly.
+type Observer interface {
+	Observe(e Event)
+}
+
+// ObserverFunc adapts a function to an Observer.
+type ObserverFunc func(e Event)
+
+// Observe calls f(e).
+func (f ObserverFunc) Observe(e Event) {
+	f(e)
+}
+
+// Event is one of AuthFailed, RefreshForced, Retried or ConnectionRetired.
+type Event interface {
+	event()
+}
+
+// AuthFailed is observed when the database rejects the credential for a staging label.
+type AuthFailed struct {
+	Stage string
+	Err   error
+}
+
+// RefreshForced is observed when the connector forces the store to refresh the credential
+// after it was rejected. Concurrent failures share a single refresh, so are observed once.
+type RefreshForced struct {
+	// Changed is false if the store returned the credential which was rejected.
+	Changed  bool
+	Duration time.Duration
+	Err      error
+}
+
+// Retried is observed with the outcome of retrying a connection with a refreshed credential
+// (for the AWSCURRENT stage) or a fallback stage.
+type Retried struct {
+	Stage string
+	// Err is nil if the retry connected.
+	Err error
+}
+
+// ConnectionRetired is observed when a pooled connection is discarded because it was
+// opened with a credential which has since been superseded.
+type ConnectionRetired struct{}
+
+func (AuthFailed) event()        {}
+func (RefreshForced) event()     {}
+func (Retried) event()           {}
+func (ConnectionRetired) event() {}
+
+// WithObserver adds an observer, which receives events such as authentication failures and
+// forced refreshes. Can be used more than once to add several observers.
+func WithObserver(o Observer) Option {
+	return func(c *Connector) {
+		c.observers = append(c.observers, o)
+	}
+}
+
+func (c *Connector) observe(e Event) {
+	for _, o := range c.observers {
+		o.Observe(e)
+	}
+}
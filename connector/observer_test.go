@@ -0,0 +1,113 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestObserver(t *testing.T) {
+	authErr := &mysql.MySQLError{Number: 1045, Message: "Access denied"}
+	tests := []struct {
+		name     string
+		store    CredentialStore
+		driver   driver.Driver
+		expected []Event
+	}{
+		{
+			name: "a refreshed credential is retried",
+			store: &mockStore{
+				GetResults: []StoreGetResult{
+					{Credential: "old"},
+					{Credential: "new"},
+				},
+			},
+			driver: &mockDriver{
+				GetResults: []DriverGetResult{
+					{Err: authErr},
+					{Conn: minimalConn{}},
+				},
+			},
+			expected: []Event{
+				AuthFailed{Stage: StageCurrent, Err: authErr},
+				RefreshForced{Changed: true},
+				Retried{Stage: StageCurrent},
+			},
+		},
+		{
+			name:   "fallback stages are retried if the credential didn't change",
+			store:  stagedStore{},
+			driver: &stagedDriver{valid: "previous"},
+			expected: []Event{
+				AuthFailed{Stage: StageCurrent, Err: authErr},
+				RefreshForced{Changed: false},
+				Retried{Stage: StagePrevious},
+			},
+		},
+		{
+			name:   "failed retries are observed",
+			store:  stagedStore{},
+			driver: &stagedDriver{valid: "none"},
+			expected: []Event{
+				AuthFailed{Stage: StageCurrent, Err: authErr},
+				RefreshForced{Changed: false},
+				Retried{Stage: StagePrevious, Err: authErr},
+				AuthFailed{Stage: StagePrevious, Err: authErr},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			o := &recordingObserver{}
+			c := New(test.store, WithDriver(test.driver), WithObserver(o))
+			c.Connect(context.Background())
+			if actual := o.get(); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected events:\n%#v\ngot:\n%#v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestObserverConnectionRetired(t *testing.T) {
+	o := &recordingObserver{}
+	s := &switchableStore{dsn: "first"}
+	c := New(s, WithDriver(&poolDriver{}), WithObserver(o))
+	dc, err := c.Connect(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.set("second")
+	dc.(driver.Validator).IsValid()
+	expected := []Event{ConnectionRetired{}}
+	if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected events %#v, got %#v", expected, actual)
+	}
+}
+
+// recordingObserver records events, with durations removed, so that they can be compared.
+type recordingObserver struct {
+	m      sync.Mutex
+	events []Event
+}
+
+func (o *recordingObserver) Observe(e Event) {
+	if rf, ok := e.(RefreshForced); ok {
+		rf.Duration = 0
+		e = rf
+	}
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.events = append(o.events, e)
+}
+
+func (o *recordingObserver) get() []Event {
+	o.m.Lock()
+	defer o.m.Unlock()
+	return append([]Event{}, o.events...)
+}
@@ -0,0 +1,36 @@
+// Package pgxstdlib configures the connector to use the github.com/jackc/pgx/v5/stdlib
+// PostgreSQL driver.
+package pgxstdlib
+
+import (
+	"errors"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/connector"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// PostgreSQL SQLSTATE codes which indicate that the credential should be refreshed.
+const (
+	invalidAuthorizationSpecification = "28000"
+	invalidPassword                   = "28P01"
+)
+
+// Preset configures the connector to open connections with the pgx stdlib driver, and to
+// refresh the credential when authentication fails.
+func Preset() connector.Option {
+	return func(c *connector.Connector) {
+		connector.WithDriver(stdlib.GetDefaultDriver())(c)
+		connector.WithErrorClassifier(IsAuthenticationError)(c)
+	}
+}
+
+// IsAuthenticationError returns true if the error is, or wraps, a *pgconn.PgError for an
+// invalid password (28P01) or invalid authorization specification (28000).
+func IsAuthenticationError(err error) bool {
+	var pe *pgconn.PgError
+	if !errors.As(err, &pe) {
+		return false
+	}
+	return pe.Code == invalidPassword || pe.Code == invalidAuthorizationSpecification
+}
@@ -0,0 +1,52 @@
+package pgxstdlib
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestIsAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "invalid password",
+			err:      &pgconn.PgError{Code: "28P01"},
+			expected: true,
+		},
+		{
+			name:     "invalid authorization specification",
+			err:      &pgconn.PgError{Code: "28000"},
+			expected: true,
+		},
+		{
+			name:     "wrapped errors are detected",
+			err:      fmt.Errorf("failed to connect: %w", &pgconn.PgError{Code: "28P01"}),
+			expected: true,
+		},
+		{
+			name:     "other PostgreSQL errors are not authentication errors",
+			err:      &pgconn.PgError{Code: "3D000"},
+			expected: false,
+		},
+		{
+			name:     "other errors are not authentication errors",
+			err:      errors.New("failure"),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if actual := IsAuthenticationError(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Package postgres configures the connector to use the github.com/lib/pq PostgreSQL driver.
+package postgres
+
+import (
+	"errors"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/connector"
+	"github.com/lib/pq"
+)
+
+// PostgreSQL SQLSTATE codes which indicate that the credential should be refreshed.
+const (
+	invalidAuthorizationSpecification = "28000"
+	invalidPassword                   = "28P01"
+)
+
+// Preset configures the connector to open connections with the lib/pq driver, and to
+// refresh the credential when authentication fails.
+func Preset() connector.Option {
+	return func(c *connector.Connector) {
+		connector.WithDriver(pq.Driver{})(c)
+		connector.WithErrorClassifier(IsAuthenticationError)(c)
+	}
+}
+
+// IsAuthenticationError returns true if the error is, or wraps, a *pq.Error for an invalid
+// password (28P01) or invalid authorization specification (28000).
+func IsAuthenticationError(err error) bool {
+	var pe *pq.Error
+	if !errors.As(err, &pe) {
+		return false
+	}
+	return pe.Code == invalidPassword || pe.Code == invalidAuthorizationSpecification
+}
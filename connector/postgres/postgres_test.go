@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+func TestIsAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "invalid password",
+			err:      &pq.Error{Code: "28P01"},
+			expected: true,
+		},
+		{
+			name:     "invalid authorization specification",
+			err:      &pq.Error{Code: "28000"},
+			expected: true,
+		},
+		{
+			name:     "wrapped errors are detected",
+			err:      fmt.Errorf("failed to connect: %w", &pq.Error{Code: "28P01"}),
+			expected: true,
+		},
+		{
+			name:     "other PostgreSQL errors are not authentication errors",
+			err:      &pq.Error{Code: "3D000"},
+			expected: false,
+		},
+		{
+			name:     "other errors are not authentication errors",
+			err:      errors.New("failure"),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if actual := IsAuthenticationError(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
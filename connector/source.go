@@ -0,0 +1,83 @@
+package connector
+
+import (
+	"context"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+)
+
+// credential retrieved from a store. Only one of the fields is populated, depending on
+// the type of store.
+type credential struct {
+	dsn   string
+	creds store.Credentials
+}
+
+// source adapts the different types of store.
+type source interface {
+	get(ctx context.Context, stage string, force bool) (cred credential, err error)
+	// peek returns the store's cached credential for the current stage, without
+	// retrieving it, if the store supports it.
+	peek() (cred credential, ok bool)
+	hasStages() bool
+}
+
+// dsnSource gets DSNs from a CredentialStore.
+type dsnSource struct {
+	store CredentialStore
+}
+
+func (s dsnSource) get(ctx context.Context, stage string, force bool) (cred credential, err error) {
+	switch {
+	case stage != StageCurrent:
+		cred.dsn, err = s.store.(StagedCredentialStore).GetStage(ctx, stage, force)
+	case s.isContextStore():
+		cred.dsn, err = s.store.(ContextCredentialStore).GetContext(ctx, force)
+	default:
+		cred.dsn, err = s.store.Get(force)
+	}
+	return
+}
+
+func (s dsnSource) peek() (cred credential, ok bool) {
+	if cs, isCached := s.store.(CachedCredentialStore); isCached {
+		cred.dsn, ok = cs.Cached()
+	}
+	return
+}
+
+func (s dsnSource) isContextStore() bool {
+	_, ok := s.store.(ContextCredentialStore)
+	return ok
+}
+
+func (s dsnSource) hasStages() bool {
+	_, ok := s.store.(StagedCredentialStore)
+	return ok
+}
+
+// credentialsSource gets structured credentials from a CredentialsStore.
+type credentialsSource struct {
+	store CredentialsStore
+}
+
+func (s credentialsSource) get(ctx context.Context, stage string, force bool) (cred credential, err error) {
+	if stage != StageCurrent {
+		cred.creds, err = s.store.(StagedCredentialsStore).CredentialsStage(ctx, stage, force)
+		return
+	}
+	cred.creds, err = s.store.Credentials(ctx, force)
+	return
+}
+
+func (s credentialsSource) peek() (cred credential, ok bool) {
+	if cs, isCached := s.store.(CachedCredentialsStore); isCached {
+		cred.creds, ok = cs.CachedCredentials()
+	}
+	return
+}
+
+func (s credentialsSource) hasStages() bool {
+	_, ok := s.store.(StagedCredentialsStore)
+	return ok
+}
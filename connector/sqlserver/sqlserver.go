@@ -0,0 +1,33 @@
+// Package sqlserver configures the connector to use the github.com/microsoft/go-mssqldb
+// SQL Server driver.
+package sqlserver
+
+import (
+	"errors"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/connector"
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+// loginFailed is the SQL Server error number which indicates that the credential should
+// be refreshed.
+const loginFailed = 18456
+
+// Preset configures the connector to open connections with the go-mssqldb driver, and to
+// refresh the credential when authentication fails.
+func Preset() connector.Option {
+	return func(c *connector.Connector) {
+		connector.WithDriver(&mssql.Driver{})(c)
+		connector.WithErrorClassifier(IsAuthenticationError)(c)
+	}
+}
+
+// IsAuthenticationError returns true if the error is, or wraps, a mssql.Error for a failed
+// login (18456).
+func IsAuthenticationError(err error) bool {
+	var me mssql.Error
+	if !errors.As(err, &me) {
+		return false
+	}
+	return me.Number == loginFailed
+}
@@ -0,0 +1,47 @@
+package sqlserver
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	mssql "github.com/microsoft/go-mssqldb"
+)
+
+func TestIsAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "login failed",
+			err:      mssql.Error{Number: 18456},
+			expected: true,
+		},
+		{
+			name:     "wrapped errors are detected",
+			err:      fmt.Errorf("failed to connect: %w", mssql.Error{Number: 18456}),
+			expected: true,
+		},
+		{
+			name:     "other SQL Server errors are not authentication errors",
+			err:      mssql.Error{Number: 4060},
+			expected: false,
+		},
+		{
+			name:     "other errors are not authentication errors",
+			err:      errors.New("failure"),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if actual := IsAuthenticationError(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
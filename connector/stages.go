@@ -0,0 +1,92 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+)
+
+// Secrets Manager staging labels.
+const (
+	// StageCurrent is the current version of the secret.
+	StageCurrent = "AWSCURRENT"
+	// StagePrevious is the version of the secret that was current before the last rotation.
+	StagePrevious = "AWSPREVIOUS"
+	// StagePending is the version of the secret being created by an in-progress rotation.
+	StagePending = "AWSPENDING"
+)
+
+// StagedCredentialStore is a CredentialStore which can retrieve versions of the credential
+// other than the current one. During a rotation, the current credential may not yet (or
+// no longer) match the database, so the connector falls back to other stages when the
+// current credential is rejected.
+type StagedCredentialStore interface {
+	CredentialStore
+	GetStage(ctx context.Context, stage string, force bool) (credential string, err error)
+}
+
+// WithFallbackStages sets the staging labels to try, in order, when the current credential
+// is rejected even after being refreshed. Only used if the store implements
+// StagedCredentialStore or StagedCredentialsStore. Defaults to AWSPREVIOUS.
+func WithFallbackStages(stages ...string) Option {
+	return func(c *Connector) {
+		c.fallbackStages = stages
+	}
+}
+
+// stage returns the staging label of the credential which last connected successfully.
+func (c *Connector) stage() string {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return c.lastStage
+}
+
+func (c *Connector) setStage(stage string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.lastStage = stage
+}
+
+// lastSeen returns the credential last retrieved for the stage.
+func (c *Connector) lastSeen(stage string) (cred credential, ok bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	cred, ok = c.seen[stage]
+	return
+}
+
+func (c *Connector) setLastSeen(stage string, cred credential) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.seen[stage] = cred
+}
+
+// openFallback attempts to connect using each of the fallback stages in turn, skipping
+// the stage which has already been tried. The stage which connects successfully is
+// remembered for future connections.
+func (c *Connector) openFallback(ctx context.Context, tried string, lastErr error) (conn driver.Conn, err error) {
+	if !c.src.hasStages() {
+		return nil, lastErr
+	}
+	err = lastErr
+	for _, stage := range c.fallbackStages {
+		if stage == tried || stage == StageCurrent {
+			continue
+		}
+		var creds credential
+		creds, err = c.getCredential(ctx, stage, true)
+		if err != nil {
+			return
+		}
+		conn, err = c.openStage(ctx, stage, true, creds)
+		c.observe(Retried{Stage: stage, Err: err})
+		if err == nil {
+			c.setStage(stage)
+			return
+		}
+		if !c.isAuthErr(err) {
+			return
+		}
+		c.observe(AuthFailed{Stage: stage, Err: err})
+	}
+	return
+}
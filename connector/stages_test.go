@@ -0,0 +1,129 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestFallbackStages(t *testing.T) {
+	tests := []struct {
+		name                      string
+		opts                      []Option
+		valid                     string
+		connections               int
+		expectedErr               error
+		expectedConnectionStrings []string
+		expectedStage             string
+	}{
+		{
+			name:                      "the current credential is used when it is valid",
+			valid:                     "current",
+			connections:               2,
+			expectedConnectionStrings: []string{"current", "current"},
+			expectedStage:             StageCurrent,
+		},
+		{
+			name:        "the previous credential is used if the current credential is rejected",
+			valid:       "previous",
+			connections: 1,
+			expectedConnectionStrings: []string{
+				"current",  // The forced refresh returns the same credential, so isn't retried.
+				"previous", // Fallback.
+			},
+			expectedStage: StagePrevious,
+		},
+		{
+			name:        "the stage which worked is remembered for future connections",
+			valid:       "previous",
+			connections: 2,
+			expectedConnectionStrings: []string{
+				"current",
+				"previous",
+				"previous",
+			},
+			expectedStage: StagePrevious,
+		},
+		{
+			name:        "the pending credential can be used if configured",
+			opts:        []Option{WithFallbackStages(StagePrevious, StagePending)},
+			valid:       "pending",
+			connections: 1,
+			expectedConnectionStrings: []string{
+				"current",
+				"previous",
+				"pending",
+			},
+			expectedStage: StagePending,
+		},
+		{
+			name:        "the last authentication error is returned if all stages are rejected",
+			valid:       "none",
+			connections: 1,
+			expectedConnectionStrings: []string{
+				"current",
+				"previous",
+			},
+			expectedErr:   &mysql.MySQLError{Number: 1045, Message: "Access denied"},
+			expectedStage: StageCurrent,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			d := &stagedDriver{
+				valid: test.valid,
+			}
+			c := New(stagedStore{}, test.opts...)
+			c.d = func() driver.Driver { return d }
+			var err error
+			for i := 0; i < test.connections; i++ {
+				_, err = c.Connect(context.Background())
+			}
+			if !errorsEqual(test.expectedErr, err) {
+				t.Errorf("expected error %v, got: %v", test.expectedErr, err)
+			}
+			if !reflect.DeepEqual(test.expectedConnectionStrings, d.ConnectionStrings) {
+				t.Errorf("expected connection strings %v, got %v", test.expectedConnectionStrings, d.ConnectionStrings)
+			}
+			if c.stage() != test.expectedStage {
+				t.Errorf("expected stage %v, got %v", test.expectedStage, c.stage())
+			}
+		})
+	}
+}
+
+// stagedStore returns the lower case name of the stage as the credential.
+type stagedStore struct{}
+
+func (ss stagedStore) Get(force bool) (credential string, err error) {
+	return "current", nil
+}
+
+func (ss stagedStore) GetStage(ctx context.Context, stage string, force bool) (credential string, err error) {
+	switch stage {
+	case StagePrevious:
+		return "previous", nil
+	case StagePending:
+		return "pending", nil
+	}
+	return ss.Get(force)
+}
+
+// stagedDriver rejects any credential other than the valid one.
+type stagedDriver struct {
+	valid             string
+	ConnectionStrings []string
+}
+
+func (sd *stagedDriver) Open(dsn string) (conn driver.Conn, err error) {
+	sd.ConnectionStrings = append(sd.ConnectionStrings, dsn)
+	if dsn != sd.valid {
+		return nil, &mysql.MySQLError{Number: 1045, Message: "Access denied"}
+	}
+	return
+}
@@ -0,0 +1,43 @@
+package connector
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/a-h/go-sql-driver-rds-credentials/connector"
+
+// Span attributes. Credentials are never recorded.
+const (
+	attrStage   = attribute.Key("connector.stage")
+	attrForced  = attribute.Key("connector.forced")
+	attrRetry   = attribute.Key("connector.retry")
+	attrChanged = attribute.Key("connector.changed")
+)
+
+// WithTracerProvider records OpenTelemetry spans for each connection ("connector.Connect"),
+// getting the credential from the store ("connector.GetCredential"), opening the
+// connection with the driver ("connector.Open") and forcing a refresh after the credential
+// was rejected ("connector.Refresh"). Spans are children of the span in the context passed
+// to Connect, which is also passed to the store, so the store's spans are nested within the
+// connector's. By default, no spans are recorded.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Connector) {
+		c.tracer = tp.Tracer(instrumentationName)
+	}
+}
+
+func defaultTracer() trace.Tracer {
+	return noop.NewTracerProvider().Tracer(instrumentationName)
+}
+
+// endSpan ends the span, recording the error if there is one.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
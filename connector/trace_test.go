@@ -0,0 +1,207 @@
+package connector
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// tracedSpan is the subset of a recorded span which is compared in tests.
+type tracedSpan struct {
+	Name       string
+	Parent     string
+	Attributes map[attribute.Key]interface{}
+	Failed     bool
+}
+
+// tracedSpans returns the ended spans, in the order they ended.
+func tracedSpans(exporter *tracetest.InMemoryExporter) (spans []tracedSpan) {
+	stubs := exporter.GetSpans()
+	names := make(map[string]string)
+	for _, s := range stubs {
+		names[s.SpanContext.SpanID().String()] = s.Name
+	}
+	for _, s := range stubs {
+		attrs := make(map[attribute.Key]interface{})
+		for _, kv := range s.Attributes {
+			attrs[kv.Key] = kv.Value.AsInterface()
+		}
+		spans = append(spans, tracedSpan{
+			Name:       s.Name,
+			Parent:     names[s.Parent.SpanID().String()],
+			Attributes: attrs,
+			Failed:     s.Status.Code == codes.Error,
+		})
+	}
+	return
+}
+
+func TestTracing(t *testing.T) {
+	authErr := &mysql.MySQLError{Number: 1045, Message: "Access denied"}
+	tests := []struct {
+		name     string
+		store    CredentialStore
+		driver   driver.Driver
+		expected []tracedSpan
+	}{
+		{
+			name:   "connect",
+			store:  &mockStore{GetResults: []StoreGetResult{{Credential: "dsn"}}},
+			driver: &mockDriver{GetResults: []DriverGetResult{{Conn: minimalConn{}}}},
+			expected: []tracedSpan{
+				{
+					Name:       "connector.GetCredential",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrStage: StageCurrent, attrForced: false},
+				},
+				{
+					Name:       "connector.Open",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrStage: StageCurrent, attrRetry: false},
+				},
+				{
+					Name:       "connector.Connect",
+					Parent:     "test",
+					Attributes: map[attribute.Key]interface{}{attrStage: StageCurrent},
+				},
+			},
+		},
+		{
+			name: "a refreshed credential is retried",
+			store: &mockStore{GetResults: []StoreGetResult{
+				{Credential: "old"},
+				{Credential: "new"},
+			}},
+			driver: &mockDriver{GetResults: []DriverGetResult{
+				{Err: authErr},
+				{Conn: minimalConn{}},
+			}},
+			expected: []tracedSpan{
+				{Name: "connector.GetCredential", Parent: "connector.Connect"},
+				{Name: "connector.Open", Parent: "connector.Connect", Failed: true},
+				{
+					Name:       "connector.GetCredential",
+					Parent:     "connector.Refresh",
+					Attributes: map[attribute.Key]interface{}{attrStage: StageCurrent, attrForced: true},
+				},
+				{
+					Name:       "connector.Refresh",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrChanged: true},
+				},
+				{
+					Name:       "connector.Open",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrStage: StageCurrent, attrRetry: true},
+				},
+				{Name: "connector.Connect", Parent: "test"},
+			},
+		},
+		{
+			name:   "fallback stages are retried",
+			store:  stagedStore{},
+			driver: &stagedDriver{valid: "previous"},
+			expected: []tracedSpan{
+				{Name: "connector.GetCredential", Parent: "connector.Connect"},
+				{Name: "connector.Open", Parent: "connector.Connect", Failed: true},
+				{Name: "connector.GetCredential", Parent: "connector.Refresh"},
+				{
+					Name:       "connector.Refresh",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrChanged: false},
+				},
+				{
+					Name:       "connector.GetCredential",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrStage: StagePrevious, attrForced: true},
+				},
+				{
+					Name:       "connector.Open",
+					Parent:     "connector.Connect",
+					Attributes: map[attribute.Key]interface{}{attrStage: StagePrevious, attrRetry: true},
+				},
+				{Name: "connector.Connect", Parent: "test"},
+			},
+		},
+		{
+			name:   "failures are recorded",
+			store:  stagedStore{},
+			driver: &stagedDriver{valid: "none"},
+			expected: []tracedSpan{
+				{Name: "connector.GetCredential", Parent: "connector.Connect"},
+				{Name: "connector.Open", Parent: "connector.Connect", Failed: true},
+				{Name: "connector.GetCredential", Parent: "connector.Refresh"},
+				{Name: "connector.Refresh", Parent: "connector.Connect"},
+				{Name: "connector.GetCredential", Parent: "connector.Connect"},
+				{Name: "connector.Open", Parent: "connector.Connect", Failed: true},
+				{Name: "connector.Connect", Parent: "test", Failed: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			c := New(test.store, WithDriver(test.driver), WithTracerProvider(tp))
+
+			ctx, root := tp.Tracer("test").Start(context.Background(), "test")
+			c.Connect(ctx)
+			root.End()
+
+			actual := tracedSpans(exporter)
+			// The root span is last.
+			actual = actual[:len(actual)-1]
+			if len(actual) != len(test.expected) {
+				t.Fatalf("expected %d spans, got %d: %+v", len(test.expected), len(actual), actual)
+			}
+			for i, expected := range test.expected {
+				if expected.Name != actual[i].Name || expected.Parent != actual[i].Parent || expected.Failed != actual[i].Failed {
+					t.Errorf("span %d: expected %s (parent %s, failed %v), got %s (parent %s, failed %v)", i,
+						expected.Name, expected.Parent, expected.Failed, actual[i].Name, actual[i].Parent, actual[i].Failed)
+				}
+				if expected.Attributes != nil && !reflect.DeepEqual(expected.Attributes, actual[i].Attributes) {
+					t.Errorf("span %d: expected attributes %v, got %v", i, expected.Attributes, actual[i].Attributes)
+				}
+			}
+		})
+	}
+}
+
+func TestTracingPropagatesContextToStore(t *testing.T) {
+	t.Parallel()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	s := store.New("secret_ARN", store.WithTracerProvider(tp), store.WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		return sm.Value{SecretString: "dsn", VersionID: "v1"}, nil
+	}))
+	c := New(s, WithDriver(&mockDriver{GetResults: []DriverGetResult{{Conn: minimalConn{}}}}), WithTracerProvider(tp))
+	if _, err := c.Connect(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var actual []string
+	for _, span := range tracedSpans(exporter) {
+		actual = append(actual, span.Name+" < "+span.Parent)
+	}
+	expected := []string{
+		"secretsmanager.GetSecretValue < store.Fetch",
+		"store.Fetch < store.Get",
+		"store.Get < connector.GetCredential",
+		"connector.GetCredential < connector.Connect",
+		"connector.Open < connector.Connect",
+		"connector.Connect < ",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected spans %v, got %v", expected, actual)
+	}
+}
@@ -0,0 +1,251 @@
+// Package metrics exports Prometheus metrics for the credential stores and connectors, by
+// observing their events.
+//
+//	c := metrics.New()
+//	prometheus.MustRegister(c)
+//	s, err := store.NewRDS(name, dbName, params, store.WithObserver(c.StoreObserver()))
+//	conn, err := connector.NewMySQL(s, s.Config(), connector.WithObserver(c.ConnectorObserver(name)))
+//
+// The cache hit ratio can be calculated from the cache requests, e.g.
+//
+//	sum by (secret) (rate(rds_credentials_cache_requests_total{result="hit"}[5m]))
+//	  / sum by (secret) (rate(rds_credentials_cache_requests_total[5m]))
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/connector"
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "rds_credentials"
+
+// Collector of metrics, which implements prometheus.Collector. All metrics are labelled by
+// the name of the secret.
+type Collector struct {
+	calls            *prometheus.CounterVec
+	callDuration     *prometheus.HistogramVec
+	refreshes        *prometheus.CounterVec
+	refreshDuration  *prometheus.HistogramVec
+	cacheRequests    *prometheus.CounterVec
+	forcedRefreshes  *prometheus.CounterVec
+	skippedRefreshes *prometheus.CounterVec
+	retries          *prometheus.CounterVec
+	staleValues      *prometheus.CounterVec
+	versionChanges   *prometheus.CounterVec
+	authFailures     *prometheus.CounterVec
+	connectRetries   *prometheus.CounterVec
+	retiredConns     *prometheus.CounterVec
+	sinceRefreshDesc *prometheus.Desc
+	versionAgeDesc   *prometheus.Desc
+	m                *sync.Mutex
+	secrets          map[string]*secretState
+	now              func() time.Time
+}
+
+// secretState is used to calculate the time since the last successful refresh, and the age
+// of the current version.
+type secretState struct {
+	lastRefreshed time.Time
+	versionID     string
+	versionSince  time.Time
+}
+
+// New creates a Collector.
+func New() *Collector {
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "secretsmanager_calls_total",
+			Help:      "Calls to Secrets Manager, counting each retried call separately, by result (success or error).",
+		}, []string{"secret", "stage", "result"}),
+		callDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "secretsmanager_call_duration_seconds",
+			Help:      "Time taken by each call to Secrets Manager.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"secret", "stage"}),
+		refreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "refreshes_total",
+			Help:      "Refreshes of the secret, by result (success or error) after any retries.",
+		}, []string{"secret", "stage", "result"}),
+		refreshDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "refresh_duration_seconds",
+			Help:      "Time taken to refresh the secret, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"secret", "stage"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "cache_requests_total",
+			Help:      "Requests for the secret which weren't forced, by result (hit or miss).",
+		}, []string{"secret", "result"}),
+		forcedRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "forced_refreshes_total",
+			Help:      "Refreshes of the secret forced by the caller, e.g. after the credential was rejected.",
+		}, []string{"secret"}),
+		skippedRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "forced_refreshes_skipped_total",
+			Help:      "Forced refreshes which returned the cached value, by reason (joined another refresh, or rate_limited).",
+		}, []string{"secret", "reason"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "secretsmanager_retries_total",
+			Help:      "Failed calls to Secrets Manager which were retried.",
+		}, []string{"secret"}),
+		staleValues: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stale_values_served_total",
+			Help:      "Expired values returned because the secret couldn't be retrieved, or was being retrieved.",
+		}, []string{"secret"}),
+		versionChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "version_changes_total",
+			Help:      "Refreshes which returned a new version of the secret.",
+		}, []string{"secret"}),
+		authFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "auth_failures_total",
+			Help:      "Connection attempts rejected by the database because of the credential, by staging label.",
+		}, []string{"secret", "stage"}),
+		connectRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "connect_retries_total",
+			Help:      "Connection attempts retried after an authentication failure, by staging label and result (success or error).",
+		}, []string{"secret", "stage", "result"}),
+		retiredConns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "connections_retired_total",
+			Help:      "Pooled connections discarded because their credential was superseded.",
+		}, []string{"secret"}),
+		sinceRefreshDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "seconds_since_last_refresh"),
+			"Time since the secret was last retrieved successfully.", []string{"secret"}, nil),
+		versionAgeDesc: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "version_age_seconds"),
+			"Time since the current version of the secret was created, or first retrieved if the creation date is unknown.", []string{"secret"}, nil),
+		m:       &sync.Mutex{},
+		secrets: make(map[string]*secretState),
+		now:     time.Now,
+	}
+}
+
+func (c *Collector) vectors() []prometheus.Collector {
+	return []prometheus.Collector{c.calls, c.callDuration, c.refreshes, c.refreshDuration, c.cacheRequests,
+		c.forcedRefreshes, c.skippedRefreshes, c.retries, c.staleValues, c.versionChanges, c.authFailures,
+		c.connectRetries, c.retiredConns}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, v := range c.vectors() {
+		v.Describe(ch)
+	}
+	ch <- c.sinceRefreshDesc
+	ch <- c.versionAgeDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, v := range c.vectors() {
+		v.Collect(ch)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	now := c.now()
+	for name, s := range c.secrets {
+		ch <- prometheus.MustNewConstMetric(c.sinceRefreshDesc, prometheus.GaugeValue, now.Sub(s.lastRefreshed).Seconds(), name)
+		ch <- prometheus.MustNewConstMetric(c.versionAgeDesc, prometheus.GaugeValue, now.Sub(s.versionSince).Seconds(), name)
+	}
+}
+
+// StoreObserver returns an observer for use with store.WithObserver.
+func (c *Collector) StoreObserver() store.Observer {
+	return store.ObserverFunc(c.observeStore)
+}
+
+func (c *Collector) observeStore(e store.Event) {
+	switch e := e.(type) {
+	case store.CacheHit:
+		c.cacheRequests.WithLabelValues(e.Secret, "hit").Inc()
+	case store.CacheMiss:
+		c.cacheRequests.WithLabelValues(e.Secret, "miss").Inc()
+	case store.RefreshStarted:
+		if e.Forced {
+			c.forcedRefreshes.WithLabelValues(e.Secret).Inc()
+		}
+	case store.ForcedRefreshSkipped:
+		reason := "joined"
+		if e.RateLimited {
+			reason = "rate_limited"
+		}
+		c.skippedRefreshes.WithLabelValues(e.Secret, reason).Inc()
+	case store.CallCompleted:
+		c.calls.WithLabelValues(e.Secret, e.Stage, result(e.Err)).Inc()
+		c.callDuration.WithLabelValues(e.Secret, e.Stage).Observe(e.Duration.Seconds())
+	case store.RefreshSucceeded:
+		c.refreshes.WithLabelValues(e.Secret, e.Stage, "success").Inc()
+		c.refreshDuration.WithLabelValues(e.Secret, e.Stage).Observe(e.Duration.Seconds())
+		if e.Stage == sm.StageCurrent {
+			c.refreshed(e.Secret, e.VersionID, e.CreatedDate)
+		}
+	case store.RefreshFailed:
+		c.refreshes.WithLabelValues(e.Secret, e.Stage, "error").Inc()
+		c.refreshDuration.WithLabelValues(e.Secret, e.Stage).Observe(e.Duration.Seconds())
+	case store.RetryAttempted:
+		c.retries.WithLabelValues(e.Secret).Inc()
+	case store.StaleValueServed:
+		c.staleValues.WithLabelValues(e.Secret).Inc()
+	case store.VersionChanged:
+		c.versionChanges.WithLabelValues(e.Secret).Inc()
+	}
+}
+
+// refreshed records a successful refresh of the current version of the secret. The age of
+// the version is measured from when it was created, or from when it was first seen if the
+// creation date is zero.
+func (c *Collector) refreshed(secret, versionID string, created time.Time) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	now := c.now()
+	s, ok := c.secrets[secret]
+	if !ok {
+		s = &secretState{}
+		c.secrets[secret] = s
+	}
+	s.lastRefreshed = now
+	if !ok || s.versionID != versionID {
+		s.versionID = versionID
+		s.versionSince = now
+	}
+	if !created.IsZero() {
+		s.versionSince = created
+	}
+}
+
+// ConnectorObserver returns an observer for use with connector.WithObserver, labelling the
+// metrics with the name of the connector's secret.
+func (c *Collector) ConnectorObserver(secret string) connector.Observer {
+	return connector.ObserverFunc(func(e connector.Event) {
+		switch e := e.(type) {
+		case connector.AuthFailed:
+			c.authFailures.WithLabelValues(secret, e.Stage).Inc()
+		case connector.Retried:
+			c.connectRetries.WithLabelValues(secret, e.Stage, result(e.Err)).Inc()
+		case connector.ConnectionRetired:
+			c.retiredConns.WithLabelValues(secret).Inc()
+		}
+	})
+}
+
+func result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "success"
+}
@@ -0,0 +1,242 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/connector"
+	"github.com/a-h/go-sql-driver-rds-credentials/store"
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestStoreMetrics(t *testing.T) {
+	errRetrieve := errors.New("retrieve failed")
+	tests := []struct {
+		name     string
+		events   []store.Event
+		metrics  []string
+		expected string
+	}{
+		{
+			name: "calls are counted by stage and result",
+			events: []store.Event{
+				store.CallCompleted{Secret: "a", Stage: sm.StageCurrent},
+				store.CallCompleted{Secret: "a", Stage: sm.StageCurrent},
+				store.CallCompleted{Secret: "a", Stage: sm.StagePrevious, Err: errRetrieve},
+				store.CallCompleted{Secret: "b", Stage: sm.StageCurrent},
+			},
+			metrics: []string{"rds_credentials_secretsmanager_calls_total"},
+			expected: `
+# HELP rds_credentials_secretsmanager_calls_total Calls to Secrets Manager, counting each retried call separately, by result (success or error).
+# TYPE rds_credentials_secretsmanager_calls_total counter
+rds_credentials_secretsmanager_calls_total{result="error",secret="a",stage="AWSPREVIOUS"} 1
+rds_credentials_secretsmanager_calls_total{result="success",secret="a",stage="AWSCURRENT"} 2
+rds_credentials_secretsmanager_calls_total{result="success",secret="b",stage="AWSCURRENT"} 1
+`,
+		},
+		{
+			name: "refreshes are counted by stage and result",
+			events: []store.Event{
+				store.RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v1"},
+				store.RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v1"},
+				store.RefreshFailed{Secret: "a", Stage: sm.StagePrevious, Err: errRetrieve},
+			},
+			metrics: []string{"rds_credentials_refreshes_total"},
+			expected: `
+# HELP rds_credentials_refreshes_total Refreshes of the secret, by result (success or error) after any retries.
+# TYPE rds_credentials_refreshes_total counter
+rds_credentials_refreshes_total{result="error",secret="a",stage="AWSPREVIOUS"} 1
+rds_credentials_refreshes_total{result="success",secret="a",stage="AWSCURRENT"} 2
+`,
+		},
+		{
+			name: "cache hits and misses are counted",
+			events: []store.Event{
+				store.CacheMiss{Secret: "a", Stage: sm.StageCurrent},
+				store.CacheHit{Secret: "a", Stage: sm.StageCurrent},
+				store.CacheHit{Secret: "a", Stage: sm.StageCurrent},
+			},
+			metrics: []string{"rds_credentials_cache_requests_total"},
+			expected: `
+# HELP rds_credentials_cache_requests_total Requests for the secret which weren't forced, by result (hit or miss).
+# TYPE rds_credentials_cache_requests_total counter
+rds_credentials_cache_requests_total{result="hit",secret="a"} 2
+rds_credentials_cache_requests_total{result="miss",secret="a"} 1
+`,
+		},
+		{
+			name: "forced refreshes, skipped forced refreshes, retries and stale values are counted",
+			events: []store.Event{
+				store.RefreshStarted{Secret: "a", Stage: sm.StageCurrent},
+				store.RefreshStarted{Secret: "a", Stage: sm.StageCurrent, Forced: true},
+				store.ForcedRefreshSkipped{Secret: "a", Stage: sm.StageCurrent},
+				store.ForcedRefreshSkipped{Secret: "a", Stage: sm.StageCurrent, RateLimited: true},
+				store.ForcedRefreshSkipped{Secret: "a", Stage: sm.StageCurrent, RateLimited: true},
+				store.RetryAttempted{Secret: "a", Stage: sm.StageCurrent, Attempt: 1, Err: errRetrieve},
+				store.StaleValueServed{Secret: "a", Err: errRetrieve},
+			},
+			metrics: []string{
+				"rds_credentials_forced_refreshes_total",
+				"rds_credentials_forced_refreshes_skipped_total",
+				"rds_credentials_secretsmanager_retries_total",
+				"rds_credentials_stale_values_served_total",
+			},
+			expected: `
+# HELP rds_credentials_forced_refreshes_total Refreshes of the secret forced by the caller, e.g. after the credential was rejected.
+# TYPE rds_credentials_forced_refreshes_total counter
+rds_credentials_forced_refreshes_total{secret="a"} 1
+# HELP rds_credentials_forced_refreshes_skipped_total Forced refreshes which returned the cached value, by reason (joined another refresh, or rate_limited).
+# TYPE rds_credentials_forced_refreshes_skipped_total counter
+rds_credentials_forced_refreshes_skipped_total{reason="joined",secret="a"} 1
+rds_credentials_forced_refreshes_skipped_total{reason="rate_limited",secret="a"} 2
+# HELP rds_credentials_secretsmanager_retries_total Failed calls to Secrets Manager which were retried.
+# TYPE rds_credentials_secretsmanager_retries_total counter
+rds_credentials_secretsmanager_retries_total{secret="a"} 1
+# HELP rds_credentials_stale_values_served_total Expired values returned because the secret couldn't be retrieved, or was being retrieved.
+# TYPE rds_credentials_stale_values_served_total counter
+rds_credentials_stale_values_served_total{secret="a"} 1
+`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := New()
+			o := c.StoreObserver()
+			for _, e := range tt.events {
+				o.Observe(e)
+			}
+			if err := testutil.CollectAndCompare(c, strings.NewReader(tt.expected), tt.metrics...); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestAge(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	c := New()
+	c.now = func() time.Time { return now }
+	o := c.StoreObserver()
+
+	o.Observe(store.RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v1"})
+	now = now.Add(time.Minute)
+	o.Observe(store.RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v1"})
+	// Other stages don't change the current version.
+	o.Observe(store.RefreshSucceeded{Secret: "a", Stage: sm.StagePrevious, VersionID: "v0"})
+	now = now.Add(time.Minute)
+	o.Observe(store.RefreshSucceeded{Secret: "b", Stage: sm.StageCurrent, VersionID: "v1"})
+	now = now.Add(time.Minute)
+
+	expected := `
+# HELP rds_credentials_seconds_since_last_refresh Time since the secret was last retrieved successfully.
+# TYPE rds_credentials_seconds_since_last_refresh gauge
+rds_credentials_seconds_since_last_refresh{secret="a"} 120
+rds_credentials_seconds_since_last_refresh{secret="b"} 60
+# HELP rds_credentials_version_age_seconds Time since the current version of the secret was created, or first retrieved if the creation date is unknown.
+# TYPE rds_credentials_version_age_seconds gauge
+rds_credentials_version_age_seconds{secret="a"} 180
+rds_credentials_version_age_seconds{secret="b"} 60
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected),
+		"rds_credentials_seconds_since_last_refresh", "rds_credentials_version_age_seconds"); err != nil {
+		t.Error(err)
+	}
+
+	o.Observe(store.RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v2"})
+	now = now.Add(time.Second)
+	expected = `
+# HELP rds_credentials_version_age_seconds Time since the current version of the secret was created, or first retrieved if the creation date is unknown.
+# TYPE rds_credentials_version_age_seconds gauge
+rds_credentials_version_age_seconds{secret="a"} 1
+rds_credentials_version_age_seconds{secret="b"} 61
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected), "rds_credentials_version_age_seconds"); err != nil {
+		t.Error(err)
+	}
+
+	// The creation date is used when the retriever provides it.
+	o.Observe(store.RefreshSucceeded{Secret: "b", Stage: sm.StageCurrent, VersionID: "v1", CreatedDate: now.Add(-time.Hour)})
+	expected = `
+# HELP rds_credentials_version_age_seconds Time since the current version of the secret was created, or first retrieved if the creation date is unknown.
+# TYPE rds_credentials_version_age_seconds gauge
+rds_credentials_version_age_seconds{secret="a"} 1
+rds_credentials_version_age_seconds{secret="b"} 3600
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected), "rds_credentials_version_age_seconds"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConnectorMetrics(t *testing.T) {
+	t.Parallel()
+	authErr := errors.New("access denied")
+	c := New()
+	o := c.ConnectorObserver("a")
+	o.Observe(connector.AuthFailed{Stage: connector.StageCurrent, Err: authErr})
+	o.Observe(connector.RefreshForced{Changed: false})
+	o.Observe(connector.Retried{Stage: connector.StagePrevious, Err: authErr})
+	o.Observe(connector.AuthFailed{Stage: connector.StagePrevious, Err: authErr})
+	o.Observe(connector.Retried{Stage: connector.StagePending})
+	o.Observe(connector.ConnectionRetired{})
+
+	expected := `
+# HELP rds_credentials_auth_failures_total Connection attempts rejected by the database because of the credential, by staging label.
+# TYPE rds_credentials_auth_failures_total counter
+rds_credentials_auth_failures_total{secret="a",stage="AWSCURRENT"} 1
+rds_credentials_auth_failures_total{secret="a",stage="AWSPREVIOUS"} 1
+# HELP rds_credentials_connect_retries_total Connection attempts retried after an authentication failure, by staging label and result (success or error).
+# TYPE rds_credentials_connect_retries_total counter
+rds_credentials_connect_retries_total{result="error",secret="a",stage="AWSPREVIOUS"} 1
+rds_credentials_connect_retries_total{result="success",secret="a",stage="AWSPENDING"} 1
+# HELP rds_credentials_connections_retired_total Pooled connections discarded because their credential was superseded.
+# TYPE rds_credentials_connections_retired_total counter
+rds_credentials_connections_retired_total{secret="a"} 1
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected),
+		"rds_credentials_auth_failures_total", "rds_credentials_connect_retries_total", "rds_credentials_connections_retired_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecretIntegration(t *testing.T) {
+	t.Parallel()
+	c := New()
+	var calls int
+	s := store.New("a",
+		store.WithObserver(c.StoreObserver()),
+		store.WithRetryPolicy(sm.RetryPolicy{MaxAttempts: 2, IsRetryable: func(err error) bool { return true }}),
+		store.WithRetrieveFunc(func(ctx context.Context, name, stage string) (sm.Value, error) {
+			calls++
+			if calls == 1 {
+				return sm.Value{}, errors.New("throttled")
+			}
+			return sm.Value{SecretString: "value", VersionID: "v1"}, nil
+		}))
+	for i := 0; i < 3; i++ {
+		if _, err := s.Get(false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := testutil.ToFloat64(c.calls.WithLabelValues("a", sm.StageCurrent, "error")); n != 1 {
+		t.Errorf("expected 1 failed call, got %v", n)
+	}
+	if n := testutil.ToFloat64(c.calls.WithLabelValues("a", sm.StageCurrent, "success")); n != 1 {
+		t.Errorf("expected 1 successful call, got %v", n)
+	}
+	if n := testutil.ToFloat64(c.refreshes.WithLabelValues("a", sm.StageCurrent, "success")); n != 1 {
+		t.Errorf("expected 1 refresh, got %v", n)
+	}
+	if n := testutil.ToFloat64(c.cacheRequests.WithLabelValues("a", "hit")); n != 2 {
+		t.Errorf("expected 2 cache hits, got %v", n)
+	}
+	if n := testutil.CollectAndCount(c, "rds_credentials_secretsmanager_call_duration_seconds"); n != 1 {
+		t.Errorf("expected 1 histogram, got %d", n)
+	}
+}
@@ -33,7 +33,10 @@ func pingDB(secretARN, databaseName string) error {
 	if err != nil {
 		return fmt.Errorf("pingDB: failed to create store: %v", err)
 	}
-	c := connector.New(s)
+	c, err := connector.NewMySQL(s, s.Config())
+	if err != nil {
+		return fmt.Errorf("pingDB: failed to create connector: %v", err)
+	}
 	db := sql.OpenDB(c)
 	return db.Ping()
 }
@@ -1,24 +1,154 @@
+// Package certs contains the CA certificates used to verify connections to Amazon RDS.
+//
+// The bundles directory contains a PEM file for each partition (global.pem for the aws
+// partition, aws-us-gov.pem and aws-cn.pem) and optionally for individual regions
+// (e.g. eu-west-1.pem). Run make update to download the latest bundles.
 package certs
 
 import (
-	"io/ioutil"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
 
-	"github.com/rakyll/statik/fs"
+//go:embed bundles/*.pem
+var bundles embed.FS
 
-	// Used to import data with statik.
-	_ "github.com/a-h/go-sql-driver-rds-credentials/store/certs/statik"
+// Partitions.
+const (
+	PartitionAWS      = "aws"
+	PartitionAWSUSGov = "aws-us-gov"
+	PartitionAWSCN    = "aws-cn"
 )
 
-// Load the certificates.
+// ErrNoBundle is returned when there are no certificates for a region or partition.
+var ErrNoBundle = errors.New("certs: no certificate bundle")
+
+// partitionBundles are the names of the bundle for each partition.
+var partitionBundles = map[string]string{
+	PartitionAWS:      "global",
+	PartitionAWSUSGov: PartitionAWSUSGov,
+	PartitionAWSCN:    PartitionAWSCN,
+}
+
+// Load the certificates for the aws partition, which covers all commercial regions.
 func Load() (certs []byte, err error) {
-	sfs, err := fs.New()
-	if err != nil {
-		return
+	return LoadPartition(PartitionAWS)
+}
+
+// LoadPartition loads the certificates for the partition, e.g. "aws-us-gov". The bundles of
+// other partitions can't verify its servers, so ErrNoBundle is returned if the partition's
+// bundle isn't embedded.
+func LoadPartition(partition string) (certs []byte, err error) {
+	name, ok := partitionBundles[partition]
+	if !ok {
+		return nil, fmt.Errorf("%w for unknown partition %q", ErrNoBundle, partition)
+	}
+	certs, err = load(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = fmt.Errorf("%w for partition %q", ErrNoBundle, partition)
+	}
+	return
+}
+
+// LoadRegion loads the certificates for the region, e.g. "eu-west-1", or the certificates
+// for the region's partition if there's no bundle specific to the region.
+func LoadRegion(region string) (certs []byte, err error) {
+	if region == "" || strings.ContainsAny(region, "/.") {
+		return nil, fmt.Errorf("%w for region %q", ErrNoBundle, region)
+	}
+	certs, err = load(region)
+	if errors.Is(err, fs.ErrNotExist) {
+		return LoadPartition(PartitionForRegion(region))
+	}
+	return
+}
+
+// ForHost loads the certificates for the region of the RDS host, e.g.
+// "db.abc123.us-gov-west-1.rds.amazonaws.com", or the certificates for the aws partition if
+// the host isn't an RDS endpoint.
+func ForHost(host string) (certs []byte, err error) {
+	if region, ok := RegionFromHost(host); ok {
+		return LoadRegion(region)
+	}
+	return Load()
+}
+
+// ForARN loads the certificates for the region of the ARN, e.g. the ARN of the secret, or
+// the certificates for the aws partition if it isn't an ARN.
+func ForARN(arn string) (certs []byte, err error) {
+	partition, region, ok := parseARN(arn)
+	if !ok {
+		return Load()
 	}
-	r, err := sfs.Open("/rds-ca.pem")
-	if err != nil {
+	if region == "" {
+		return LoadPartition(partition)
+	}
+	return LoadRegion(region)
+}
+
+// Regions returns the regions which have their own bundle.
+func Regions() (regions []string) {
+	entries, _ := bundles.ReadDir("bundles")
+	for _, e := range entries {
+		name := strings.TrimSuffix(e.Name(), ".pem")
+		if isPartitionBundle(name) {
+			continue
+		}
+		regions = append(regions, name)
+	}
+	return
+}
+
+// PartitionForRegion returns the partition which contains the region.
+func PartitionForRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionAWSUSGov
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionAWSCN
+	}
+	return PartitionAWS
+}
+
+// RegionFromHost returns the region of an RDS endpoint, including RDS Proxy and cluster
+// endpoints, e.g. "eu-west-1" for "db.abc123.eu-west-1.rds.amazonaws.com".
+func RegionFromHost(host string) (region string, ok bool) {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, suffix := range []string{".rds.amazonaws.com", ".rds.amazonaws.com.cn"} {
+		if !strings.HasSuffix(host, suffix) {
+			continue
+		}
+		labels := strings.Split(strings.TrimSuffix(host, suffix), ".")
+		if region = labels[len(labels)-1]; region != "" && len(labels) > 1 {
+			return region, true
+		}
+	}
+	return "", false
+}
+
+// parseARN returns the partition and region of an ARN.
+// arn:aws-us-gov:secretsmanager:us-gov-west-1:123456789012:secret:name
+func parseARN(arn string) (partition, region string, ok bool) {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" || parts[1] == "" {
 		return
 	}
-	defer r.Close()
-	return ioutil.ReadAll(r)
+	return parts[1], parts[3], true
+}
+
+func isPartitionBundle(name string) bool {
+	for _, b := range partitionBundles {
+		if b == name {
+			return true
+		}
+	}
+	return false
+}
+
+func load(name string) ([]byte, error) {
+	return bundles.ReadFile(path.Join("bundles", name+".pem"))
 }
@@ -1,6 +1,8 @@
 package certs
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 )
 
@@ -13,3 +15,142 @@ func TestLoad(t *testing.T) {
 		t.Fatal("failed to read all cert data")
 	}
 }
+
+func TestLoadRegionFallsBackToThePartition(t *testing.T) {
+	global, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load certs: %v", err)
+	}
+	certs, err := LoadRegion("xx-unknown-1")
+	if err != nil {
+		t.Fatalf("failed to load certs: %v", err)
+	}
+	if !bytes.Equal(certs, global) {
+		t.Error("expected the aws partition bundle")
+	}
+}
+
+func TestLoadRejectsUnknownBundles(t *testing.T) {
+	if _, err := LoadPartition("aws-unknown"); !errors.Is(err, ErrNoBundle) {
+		t.Errorf("expected ErrNoBundle for an unknown partition, got %v", err)
+	}
+	if _, err := LoadRegion("../global"); !errors.Is(err, ErrNoBundle) {
+		t.Errorf("expected ErrNoBundle for an invalid region, got %v", err)
+	}
+}
+
+func TestRegionsHaveBundles(t *testing.T) {
+	regions := Regions()
+	if len(regions) == 0 {
+		t.Skip("no region bundles are embedded, run make update to download them")
+	}
+	for _, region := range regions {
+		certs, err := LoadRegion(region)
+		if err != nil || len(certs) == 0 {
+			t.Errorf("failed to load certs for %q: %v", region, err)
+		}
+	}
+}
+
+func TestPartitionsDoNotUseTheGlobalBundle(t *testing.T) {
+	global, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load certs: %v", err)
+	}
+	for _, partition := range []string{PartitionAWSUSGov, PartitionAWSCN} {
+		certs, err := LoadPartition(partition)
+		if err != nil && !errors.Is(err, ErrNoBundle) {
+			t.Errorf("%s: expected ErrNoBundle if there's no bundle, got %v", partition, err)
+		}
+		if err == nil && bytes.Equal(certs, global) {
+			t.Errorf("%s: expected the partition's own bundle, got the global bundle", partition)
+		}
+	}
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := []struct {
+		region   string
+		expected string
+	}{
+		{region: "eu-west-1", expected: PartitionAWS},
+		{region: "us-gov-west-1", expected: PartitionAWSUSGov},
+		{region: "cn-north-1", expected: PartitionAWSCN},
+	}
+	for _, test := range tests {
+		if actual := PartitionForRegion(test.region); actual != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.region, test.expected, actual)
+		}
+	}
+}
+
+func TestRegionFromHost(t *testing.T) {
+	tests := []struct {
+		host           string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		{host: "db.abc123.eu-west-1.rds.amazonaws.com", expectedRegion: "eu-west-1", expectedOK: true},
+		{host: "cluster.cluster-ro-abc123.us-gov-west-1.rds.amazonaws.com", expectedRegion: "us-gov-west-1", expectedOK: true},
+		{host: "proxy.proxy-abc123.us-east-1.rds.amazonaws.com.", expectedRegion: "us-east-1", expectedOK: true},
+		{host: "db.abc123.cn-north-1.rds.amazonaws.com.cn", expectedRegion: "cn-north-1", expectedOK: true},
+		{host: "db.example.com"},
+		{host: "rds.amazonaws.com"},
+	}
+	for _, test := range tests {
+		region, ok := RegionFromHost(test.host)
+		if region != test.expectedRegion || ok != test.expectedOK {
+			t.Errorf("%s: expected %q, %v, got %q, %v", test.host, test.expectedRegion, test.expectedOK, region, ok)
+		}
+	}
+}
+
+func TestForARN(t *testing.T) {
+	tests := []struct {
+		arn string
+		// bundles are the files which may be used, in order of preference.
+		bundles []string
+	}{
+		{arn: "arn:aws:secretsmanager:eu-west-1:123456789012:secret:name", bundles: []string{"eu-west-1", "global"}},
+		{arn: "arn:aws-us-gov:secretsmanager:us-gov-west-1:123456789012:secret:name", bundles: []string{"us-gov-west-1", "aws-us-gov"}},
+		{arn: "arn:aws-cn:secretsmanager:cn-north-1:123456789012:secret:name", bundles: []string{"cn-north-1", "aws-cn"}},
+		{arn: "arn:aws-us-gov:secretsmanager::123456789012:secret:name", bundles: []string{"aws-us-gov"}},
+	}
+	for _, test := range tests {
+		var expected []byte
+		for _, name := range test.bundles {
+			if b, err := load(name); err == nil {
+				expected = b
+				break
+			}
+		}
+		certs, err := ForARN(test.arn)
+		if expected == nil {
+			if !errors.Is(err, ErrNoBundle) {
+				t.Errorf("%s: expected ErrNoBundle, got %v", test.arn, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.arn, err)
+			continue
+		}
+		if !bytes.Equal(certs, expected) {
+			t.Errorf("%s: expected one of the %v bundles", test.arn, test.bundles)
+		}
+	}
+}
+
+func TestForARNDefaultsToTheAWSPartition(t *testing.T) {
+	global, err := Load()
+	if err != nil {
+		t.Fatalf("failed to load certs: %v", err)
+	}
+	certs, err := ForARN("secret_name")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(certs, global) {
+		t.Error("expected the aws partition bundle")
+	}
+}
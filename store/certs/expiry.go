@@ -0,0 +1,104 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+)
+
+// Certificate in a bundle.
+type Certificate struct {
+	// Subject is the certificate's distinguished name, e.g.
+	// "CN=Amazon RDS eu-west-1 CA,OU=Amazon RDS,O=Amazon Web Services\, Inc.,...".
+	Subject   string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// Certificate is the parsed certificate.
+	Certificate *x509.Certificate
+}
+
+// ExpiresWithin returns true if the certificate has expired, or will expire within d
+// of now.
+func (c Certificate) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return !now.Add(d).Before(c.NotAfter)
+}
+
+// Expired returns true if the certificate has expired.
+func (c Certificate) Expired(now time.Time) bool {
+	return !now.Before(c.NotAfter)
+}
+
+// Parse the certificates in a PEM bundle.
+func Parse(bundle []byte) (certs []Certificate, err error) {
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		var c *x509.Certificate
+		c, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			err = fmt.Errorf("certs: could not parse certificate: %v", err)
+			return
+		}
+		certs = append(certs, newCertificate(c))
+	}
+}
+
+// Inspect parses the bundle for the partition, e.g. "aws". Use Certificate.Expired and
+// Expiring to find certificates which have expired, or are near expiry.
+func Inspect(partition string) (certs []Certificate, err error) {
+	bundle, err := LoadPartition(partition)
+	if err != nil {
+		return
+	}
+	return Parse(bundle)
+}
+
+// Expiring returns the certificates which have expired, or will expire within d of now.
+func Expiring(certs []Certificate, now time.Time, d time.Duration) (expiring []Certificate) {
+	for _, c := range certs {
+		if c.ExpiresWithin(now, d) {
+			expiring = append(expiring, c)
+		}
+	}
+	return
+}
+
+// ExpiryWarning returns a function for use as the VerifyConnection field of a tls.Config,
+// which calls warn for each CA certificate of the verified server chains which expires
+// within d. It doesn't reject the connection.
+func ExpiryWarning(d time.Duration, warn func(c Certificate)) func(cs tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		now := time.Now()
+		seen := map[*x509.Certificate]bool{}
+		for _, chain := range cs.VerifiedChains {
+			// The first certificate is the server's own.
+			for i := 1; i < len(chain); i++ {
+				if seen[chain[i]] {
+					continue
+				}
+				seen[chain[i]] = true
+				if c := newCertificate(chain[i]); c.ExpiresWithin(now, d) {
+					warn(c)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func newCertificate(c *x509.Certificate) Certificate {
+	return Certificate{
+		Subject:     c.Subject.String(),
+		NotBefore:   c.NotBefore,
+		NotAfter:    c.NotAfter,
+		Certificate: c,
+	}
+}
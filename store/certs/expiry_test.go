@@ -0,0 +1,132 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestBundledCertificatesAreNotNearExpiry fails when any bundled certificate expires within
+// the number of days in the CERTS_EXPIRY_DAYS environment variable, e.g. in CI:
+//
+//	CERTS_EXPIRY_DAYS=30 go test ./store/certs
+//
+// Run make update to download the latest bundles.
+func TestBundledCertificatesAreNotNearExpiry(t *testing.T) {
+	days, err := strconv.Atoi(os.Getenv("CERTS_EXPIRY_DAYS"))
+	if err != nil {
+		t.Skip("set CERTS_EXPIRY_DAYS to check the expiry of the bundled certificates")
+	}
+	entries, err := bundles.ReadDir("bundles")
+	if err != nil {
+		t.Fatalf("failed to read bundles: %v", err)
+	}
+	for _, e := range entries {
+		bundle, err := bundles.ReadFile("bundles/" + e.Name())
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", e.Name(), err)
+		}
+		certs, err := Parse(bundle)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", e.Name(), err)
+		}
+		for _, c := range Expiring(certs, time.Now(), time.Duration(days)*24*time.Hour) {
+			t.Errorf("%s: %s expires at %v", e.Name(), c.Subject, c.NotAfter)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	certs, err := Inspect(PartitionAWS)
+	if err != nil {
+		t.Fatalf("failed to inspect certs: %v", err)
+	}
+	if len(certs) == 0 {
+		t.Fatal("expected certificates")
+	}
+	for _, c := range certs {
+		if c.Subject == "" || c.NotAfter.IsZero() {
+			t.Errorf("expected a subject and expiry, got %+v", c)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	now := time.Now()
+	a := newTestCertificate(t, "a", now.Add(time.Hour))
+	b := newTestCertificate(t, "b", now.Add(time.Hour*24*365))
+	bundle := append(encode(a), []byte("not a certificate\n")...)
+	bundle = append(bundle, encode(b)...)
+	certs, err := Parse(bundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(certs) != 2 || certs[0].Subject != "CN=a" || certs[1].Subject != "CN=b" {
+		t.Fatalf("expected certificates a and b, got %+v", certs)
+	}
+	expiring := Expiring(certs, now, time.Hour*24)
+	if len(expiring) != 1 || expiring[0].Subject != "CN=a" {
+		t.Errorf("expected a to be expiring, got %+v", expiring)
+	}
+	if certs[0].Expired(now) || !certs[0].Expired(now.Add(time.Hour)) {
+		t.Errorf("expected a to expire in an hour")
+	}
+}
+
+func TestExpiryWarning(t *testing.T) {
+	now := time.Now()
+	server := newTestCertificate(t, "server", now.Add(time.Hour))
+	expiring := newTestCertificate(t, "expiring", now.Add(time.Hour))
+	valid := newTestCertificate(t, "valid", now.Add(time.Hour*24*365))
+	var warnings []string
+	verify := ExpiryWarning(time.Hour*24, func(c Certificate) {
+		warnings = append(warnings, c.Subject)
+	})
+	err := verify(tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{
+			{server, expiring, valid},
+			{server, expiring},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(warnings) != 1 || warnings[0] != "CN=expiring" {
+		t.Errorf("expected a single warning for the expiring CA, got %v", warnings)
+	}
+}
+
+func newTestCertificate(t *testing.T, cn string, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notAfter.Add(-time.Hour * 24 * 365 * 2),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return c
+}
+
+func encode(c *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})
+}
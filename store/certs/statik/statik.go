@@ -1,12 +0,0 @@
-// Code generated by statik. DO NOT EDIT.
-
-package statik
-
-import (
-	"github.com/rakyll/statik/fs"
-)
-
-func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xd3]\x13O\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00rds-ca.pemUT\x05\x00\x01\x1f\x8cZ]\xe4\xfdG\xb7\xbc\xe8\x95\xf5\x01\xce\xf9\x14=g\xf5\xc2C0\xe8\x01\xde\x05\xde3\xc3{\x17@`>}\xaf\x7f\xa6\x94\x95\xcaT\xd5+)sPR\xdd\xe1y\xe0\xc6:\x01\xcf\xef\xee\xbb\xd9'\xe2\xff\xfb\xe3\x87\x15$\xc5\xf8\xffp\x82\xe3)\xa2\xc21\x9e\xf0S\x15\xd0\x15\x85\xa7y\x8ec\xf6\xbbfN\x85ej\x85\xb5;\xc6`\xeb~m\xfaV\xa2O\x98el_dx\x8em;\xe1\xad3\xbd\xc4 \xbe\xc06:\x17\x04\xfa\x05\x08\x0f\xe3\xb0\xb5\x11\xb0L\xadsC\xd1\xe4h\xb3dc\x01g(~	<c\xfe\xbc\x96\xeb,b\x0cq\xe4\xc0\x99\xe4_J\xc7\xd4?\xd7g\x1d\x90|q\x8f\xa3\xe5\x9b\xb5l\x94\x84J\xed\xa3\xc1]\x8cC\x97D\xfa\xa6\x08\xc3\x11\xdf\xf8\xf5_/\xb2\xe9\\\xff\xcb\xf1\xae#\xea\x17 =L\xf2\xf3\xa2\xae\x0b\x7f\xbbX\xfb#\xfd-8\x96\xb7=\xa6\x14O\xf82<\xe6\xd6y\xff4=\xe1\xd2\x1f1\x15O\xf8\x06t\x9ey~]\xd4\x15I\xd3\xed\xed\xe4\xec\x98\x0fl[\x12N5\xf0\x1f\xc1\xd3Y\xe1\xa7\xde9\xe6\xd4\x02T|Ri8\x12\xcc\xf9\x02Y'\xd8:\x83\xff\xfc\xc6\x9c\xa7\xec\xa3AS\xc8\xce\x96xB\xabs\xcc\xcf'\xd5gb\x87HS\x8e\xf4\xa1\x88\xc5\x10\xb7\xac\x97D*\x9a\x86\xc6\x00\xe4\xf7Y\xbb!\xd1\xbd\xbb_\xbd\xcayj\xffu\x82*\xf8\x8f\x90\xe9\xec\xcf\xef>s\x9e\xee\xaf\xd7\x00\xfff\xdd\x0c\xa5aE0X]QX\xe5w\x97P\x10\x19\xc6\xe4\x18\xfb\xc5\xfcX\xe7j\x8dcl\x819\xf8\xd7\xf4I^\x01p\x80\x01\xf3\xba\x83\xc1o\x17.Q4\xcb\xe3sSy\xc7\x8b_\xbe\xbc|\x87\xe3\xd2\xb6?\xf0q\x0f0\xf2r\xf7\x82\xddR\x8c\xeb\xd8\xfa{.\xbc\xb3\"r-\x02j\x8b\x1a\x18\x88\xd1\xe0\xd6p\xc6\xb6\xd8\xa5@\xa6\xb1\x0f\xb2r\xd4h\x8d\xa2\xb8\xfb\x8e}\xa8z\x80pc\x8d[\x9e\xd7CM\xbe\xe4\x15\x9e6\x89\x17+\xe6S\x01\x8c\x99wI\xa1\xa6\xb5\xa9\xaf\x19V\x898Y\x0d\xf9\xabG\x05\xca\x93\xbd\x91v\x1f\xfcM91\x12\x16\x0c\xab\x1f\xc7gQ\xb8\xb7(`\xb3\x9cKE\xba\x98\x19\x0dx\x04\xb6\xd8\x0ex-rH\xa7{\x9fW\xd8RQuf\xc2\x9fA\x0b\\e\xf6\xcb\x89\x1d\x92v\xf1\x87\x05	\x92\x012yH\x00\xbd\xddix\xde(\x14T\x05,\x88wu\x1dK\x85m%\x13\xbb\xaf\xb2\x07R\x86q\x00\xdf\x17\xb6\xabq\xe4\xf3\xe3\x02&\xdd\xa3[\xef\x1e\x02\xb1\xac\xf4^B\xf39\xf7\\\xc2\x02k\xf9\x9e\x0b\xc0*\xe6\xba\xadA\xe0}O\x88;\x15\x9e\xb1\x19vF\xf53\xf6~\xde\x07\xb2\xfdb\x99\xea%\xb0\x8c\xce1v|\xf2g\xcc\x07\x0e\xec1\xb6\x0c\xb1\x8c\x7f\x02\xcc)\xb0\xd0\xc3\x14?\x1f\x8c\x0bbm\xfb^\xfd>><\x85\x8ay\xa3S\xee\x83\xb0\xb9dM\x8a\xad\xb8\xb1~\xca?\xff\x82\x8ee\xebS\x9c\x19\x1f\xf8\x9f\x0e\xe6\xedX\xd5\xe6Di\xbe\xb9\xf1\xe3~`m\x86\xafk\x81e\xe4$\xaf\x95v~-\xcc\x08\xe8\x9d\xcc\x13\xf9@\x9eZ\x17]n\x1ei{t\xd7\xa1\x8c\xb23\xaf\xc7\xac*\xd2wuk&\xda	\xe2\x15k\x99\\Z\xcb\x14\xb1\x88\x03\xe3\x8f\xea\x86\xccI\x00\xa8\xe0\x1f\xbc\x82,.\xdb\xd0,\xf3B!\xc2\xea\x0fc0J\xd7c\xb1\"\xe2y\xd4\x12\xac\xe2	\xc2\xcc\xd5\xbe>\xfbJ\x8ay\x0e@\xf4;\xd5o8\x19\xf1\x0f\x80S\xb6u\x17\xc8\xeb\xb6\xe4\x8f\xa3d;\xbb\xcb\x0eDr\xa7V~\xdf	F\xe3O-\\k3\x95}\xac)\xeb*D8rm~\x00K#y\xe1\xdf\xa5\xab\x00\xf4 M\x08\x8c\x05\xd4\x07\xd7\xfb\xbed\x81\x08\x0bb^\x8b\x12\x9eg\xda\xf7\x17Q\xf0T+\xc8H\x1b\xcd\x85\xd2n\xb3\x97\xc52w\x0b\xb7\xa46\x89`\xaf\x04\x00C\x171\xa5\xc8\xee\x91\xed\x9c\x06\xb5\xaf\xf3\xd2\x83\xb5*\x08i\xcf\x98\xd78.\xa5*\x9e\x13\xff\x1d\xe9\x0f+l\xf2\x9d\x0d\xf0k\x1d\xd2\x8b\xden\xe1\xdc\xfc\xf8\x03@8l\xa4gRy\xbe\xff\xff\x03~\xe2\xac`\xf0\xbfg\xef\xff\x83\xcb\x02\xe3q\x1cs\x8c\xbfp\xd9\xe1\xff\xd3\xb9|\xeb\xdd\x0f\x14'\x7f\xc3\xe5_\x8a\xba\"\xf9\xff+\xb9<\xe8\x9c\xfeW.\xe7\xbf\xe5r\x93s\xc8\x91a*\x9cJA\x93c\xf6\xae\xbb?\xfa\xe68\xc6U~\xbb'\xd9\xbf\xecI\x8b\xf9\xf1'\xd9\x9e9\xe0\xa7\x0d\xaa\x8f:\xca\xe2V\xe5E\\\xd2e\x89\x1e\xb6\xbcu_\xdf\x1e*K\xe3T\x1c\x95i\xaa\xa7\xdcZ\xc9o\xdf\xf3\x13\x91\x9csc\x9f\x93C\x12\xd3\n\x00&\xb7+\xf8\xcd\x82\xe6\xeb\x8c_/\x0e\xca\x03\xb0\xc0mtb\xf9q\xea\xc1\xe0\xa2fT\xbf\xf5\x06\xc3\xb1\x0f\xf1q0#\x05\xf1\xf6)^\xf4\xde\xdb\xc1\x0e\x87!\xf05QDsd\xa2}	\x07\xdb\xb5S6\x9b's\x1e\xa4\xa2\x82\xf2\xdd\xda\xad\x0e\x07]\xdd}F\xe1\x03\xc5\x8fh\xbd_z-\xf3P\xec\xcbe\xb3v\x0c7\x01\x1c\x0c\xb2\x14T\x1fT\x88\xaf\xea\xc3\xbeQ\xde\xac\xa8#C\xd7\xed\x91\xf6\xd3\x12\xc0\xd5~z\xce\xe1O\x01apF\x1d\x03\x01\x04!\xf1-\xa3\xc9E\xbd\x84\xba\x06\xaa\x00\xd9\xfc\xcb\xda\xe3\xba\x93d\x8a\x0c\xee\xdb$%C\xd3\xc9\x0f\xe3\xeb<\xb4\x12\xe3d1\xae\x1d(Q\xade\xc7\xf2!\x91W6\xc9\xa0\x1b\xcab\xc7\xae\xc9\n\xc8_^\xb2\xf4\xbdmCE\xc1\x11a\x94\xfc{\xb9w\x82\xc9\xee\x8dcN\x81aRc\xd4%\xfb\xe4\xeb\x9fpj\xfd\xccc\x9bgjA\xd2YE\x02\x18\xc4/\x84\x1fl>mEg\xe2\x1f\x10\xe7\x18\x9b9e\xfb\xa7\x13L\x96\x8d\x05Qi5\x1d\xb6\xc9	\xd1pa|_\x1b\x96D\x97bd\xa7p:\x80\xce\xbe~\xdc \x85r\xda\xb1\xce\xa6\x8c(\xe0\x1c\xb5\x9e \xddD\x8ag\xc2\x1bmGlG\xdc\x02'\x8c\xbc\xce\xc0\x12\xe7\xae\x92\xabd\x18\xff\xd3=\xe13\x00\x83+,c\xb3\xe5#e\x02\xed\x9cP\x8f\x96(\xd1J]GL\xaf\x0f\xa8c\xc5p\x97/\xd3\xa98\x01*\xdaN\xde\xaf\x95\xd1\xd2-\x92k-z)\xb4w\x02j\xcf\xb6R\xd8\x1e\xcf:Q\xb5I\xe8*<\xe9\xfagF\xc0zE\xe9\xb5K\xa68B\x16^\xb6\xea\xcd\xe9\xfc\xe8\xe5\x80\xdd\xe14\xb5\x8a\xa9\xae\xdcvNV\x01\xf8j\xa8\x9f\xe9\xacSj\xe9h\\o\xf2\xf7J\x1fpE\x16\x97L\x16Qv\xeb_\x14\xf1p/\xd1\xbd1\x17\x8b\xa3B\xb4:m\x83\x0b\xb1\x84[\xed8\xf2\x03\xe8\xa0s\x80\x0c\\\xea`\xa7R\x07\xd7\xf9s\xa2\xe5K\xf4\xba\xfa\x0e\x9fFwzhC\xb7\xe6\x1a;\x0b\xd3e\xe9\x9c	\xa9H\xc9\x89QNI\x82<?\x94\x02\x84\xea\x15\xe4\xb1!{\x91\xd2q\xf9T\xa3\x8f\xae\x15H\xca\xe5\x03\xaa\xb9bu\x13\xe4\xe6T\x1e\xa5\x12\xe1B\xd2\xb6\xfb\x01\xbb\xf5\xa5\xe9\x04Q\x9f\xafce\xce\x16\x08\xacO4\xa1/\x9a\xf6\x90O\xee\xedb,Z\x08\x19F\x9dt\xe4\xf0\x9f\xc9i\xef\xff\x06\xa7\xbd\xe1\xefp\xda\x1b\xfe\x8d9\xfddX\xf0\x078m\xa4\x910\x8c\xae\x10\xef\x90\xbf_\xb2\xb81\x8e\xd8\xb8~\xdax\x15\xa2\x19\xea\xe3\xc0\xfc\x98\xded\xb3\x9a\x91}8A\xa69\xa7U \xf4A|/\x80/Dd{{\x14O\xd2\x8a\x86\x05\xfc\xe4\xb7\xb6\x9b\xa7\xdc\x17\xe5\x97\x83\xae\xe6d\x07?\xef\x0b\xcc\x97\x0bY[\xbb@|\xf9\xf3]\xd7\xd7e=\xb6\xb9r\x05\x078\xa2\xbb\xaf\xe4\x1d\xc4	I\xdf\xd5R\xcd\nI1]>\xe8f7\xa0\xc1\xd2`\xd5\x1eL\xab\x05+u\xa0%\xc5SrTU\x80\xc7(\xd5\x0e}\xc5\xb0\xfd\x01\xfc\xac)\xa0!,\xb6\xcc\xf8>\x1aV!\x9e\x15\xe6\x95\xa2\xd9\xd3\xf2u\xf7\x17a)\x02\xaf\xb6\x1f\x12\x9a\x0e\xd5\xd7\xf4}T\x9d\xd3\xbbG\xb5\x82[PM\xf0\x0b\xa0\n5\xc5pdA{Y\xcbe\xbd6\xad\x90\x9aT\xbb\x1f$\x96\xa1\xe0{\xef\xfc\x80\x84\xd62s\xb1[9\xea\x0f\x84\xbe\xc6\xd3)\xab\xa1\xc6<\xf5	\xf8\x01\x10\xccoK.k\xb6\x1aY\xaf\x1f\xc2)\xf1\xdc(\xd8J\xb0\xd6\xfd\x9f\xc8i\xe6\xa01\x08\xef\x89+\x0b\xcd\xad.\xb8b\x02!\xadh\x9d\xa3\xdd\xff4N\x0fy\xe7\xde[\xbeX]E\x80z\xfd\xe8\x07\xb3q\x17{\xaf\xfe\x0e\x86\xe2\xb6\x9c\xb9\xce-g\x91\xb2\xa5lZ\xae\xfd\x89\x8c5B\xdd\x9e\xb4\x80~o\xc8\x99k\xbf\x0c\x89\xef\x9fp~\xed\xa2\xfe\xb5\xe2\xc1g\x101\x0ex\"\\\x0c\xb8\xe74\xd0\"\x16\x1e\xd7\xe4!\xe5\xb7\xa89\x1anP\x9f\x052\xad\xd7\x0e,\xe6\xed\x7f\x08\x04\xa6\x1d\xf9}\xad\xb3\xc6\x12*1\"\x11C}\xea\\\xec&\xfd:Y7\x14K\x0c\xc2\x0f\xbd\x87@\xdc#\xb0\xb8\xe2\x82\xe8\xc8I;\xc0Z\nP \xf4\xcd\xa8\xa7XA\xd0\xe2\xed;Y%\xe5\x14W\xdc\x966\xd3\x07\x9d\xbe\x1f\xc31n\xe6\xca\xab\xaf\x94@\xb8\xb9L\xd0\xa8\xee\x0e\xe9@L\xf7\xb5\x13\xb9u\x80\xacw\x0cMD\xa5\x10>J\x8d\x08q\xb1O\x82$h\x9a\x88\xbe\x1a\x84\xe9Ps\x182p\xc8\xcc\x95\xe1\xd3\xa0\x03\x19\x0f\xb3f\xd5J0\xdd\xb2e\x8d\x8bF\xe0:%\xf8m\xbe\x07\xe7\x03y\x15O\x0e-\xc2Tf\xe01j\xf4\xa5\xffLN\xff\xc7\xfb\x1c?#\xb9s\xfe\x0e\xa7;\xe7?\x86\xd3\xed?\xcbiue\x19\xf5\xd8\x1f\x92nD\xb3\xc1\xd8\xfd-'^v\n\xaf\xb2\xec$IK\xef\x89n\x0e\xfa\x15\xeb\xbc\xf5\x84\x8f\x14\x85\xdc\x18\x82I\x1c\no\x06\xc8c,7BC|i\xb8\xb8j\x91\xf8A\xb7m\xfe\xb0q;\"\xfe\x1e\x8b\xd1\xebn\xee\xc2C\x1b\x9d'\x9e\xdaF7N\xf2\x97\xa3-\xda\xee\xb0\x18\xf2IW \xc1\xf6\xa2	\x1c\x1a\xaf\xe0\xf55.\xe7\xf2E\x9f\xd5\xa1\xad|M%\xfeB\x03\x07\xb9(\n\x13\x8f\xc5\xb1\x9c\x99\x1a\x85\x10\xf9r\x98\xb2\xec2g\x0f\x16\xf4\x08\x00\xd5\xd9\xed{@_\x19\xaf\xc8(ARWM\x95\x94@\x87S\xe2\xcd\xce\xd4\x0cq\xe1\xa5|\xaan\xb2\xf7\xb4\xc4x*\xef\x16\xcf\xdb\xc6\x9d{\xcam\xbe\x90.\x00\xcdt\xf4\xdf\xabt-\x7fI\x83]L\xd9\xa6b\xd7\x15&\x88\xd2Zi\x0546\\\x93>\xa5\xa7\xe9\x8d\x0f\x9bt\xd5\xf7)\x9b\xb2\xa3\x95\x8a\xf5XFPd\x02\x13\xcaDT\xad\xcf\xc7\x8c\x8d\xfb\x17\xc3[\xdeK\xe0\x86\x8c9\xe9\xcf\xe4\xb42\xd8S\x0ckr\x1cNH\x17\x1f\xa3[\xa8quv\x90Q\x9d\x7f\x16\xa7\x19\xf8\x0c|\x12\x1ap$\xf7\x1e9\xc7\xd3'\xc79>\x8e\xd1\xb0\xa0a^\x0c[\x99\xe6\xa0\xf1\x84\xdd\xda\x9b\xe3\x8aS!\x82\xabZ\xd8\xf0\xa0\x18\xb0\x9c\xa9\xc7\xa4\xbc\xb9\xf3\x86S\xeb\xd9b\xa0\x9e.`\x08R\x9aX`\x94V\xe1\xbb[\x0c\xea.\xf3z-\xc0\xb6\x06w\xb2b(\xb4\xc0\xf4Y\xba?\xc6\x07\x02\x92\xc5m\x96a\x93v\xec\xc1m\xbb\x0d&\xad\xfa0\xf5z\x85|\xe7\xc0\n\xbc\xd8Z\x7fE)\xe9v}\x9b\xcf\x1b\xfd\xb6\xaf`W\xe1\x88\xc1\xdbRB\x04\xea\x01\x1e\xf5\x03\x92\xfb\x0b>\xc7\xe7\xfa\xf6\xca\xe4\xa6\xa1\x85]\x86\xaa\xd1\xe9\x138\xde\xa7\xb6\x13{\xf8\xf6\xd9\xc2g\xc25\xe0\xa3\xe1I$\x19\xf0X\xc6,\xe4\x8e\x85)\xc0\xe24\x04\x1d|Q%\xa3\xd5\xafk\x16\x81\xcdv-\x08	\x9b2\xfc\xb9\xd4e9M\x8cm^$m\xf5\xfc\x94ZB\x01Y\xd7IY\xe9\xc9\x81C\x0b\xd7-`8\xc1\xab\xe5\x84\xebE\xa4\xe2\x95\xdff\xb3Np\x98\x98\xe9v\x95\xe5\xbf\xcei\x1ez~p\xba\xfc/N?\xff78\xfd\x1b?\xfa\x97\xa2\xaeH\xee\xffJNw:'\xfc\x95\xd3\xe9o9=\x14.\xd2%!\x01\xe7\xa3\xb8\xbd=\xa1\xb6a\xe1\xack\xa1\xfd\xed~dl\x9f\xf9\xb1\x1d\xf9\x93\xf9\xb1\xae1\xb3\xc2\x02\x8c\xcd\x8b\xbb\x85\x16\x8d\xfb>R\xcbT\xf0\xe4\xf3\xb1\xc2M\xc3\xcd\x98\x9es\x9b]\xb0\x9bYdDS\xe3Q\xa1\xcf\xc5\xd6\x12	\xd28\x19\x15Hs\xa6T\xe6\x04l\xd3\xf1	\x84\xfe\xc0\x18\xe6\x81\xa2\x89&\xd4\"Z\xc3\xf8 \xf7\xe7\x92\xa2[^\xd4\xedu\xbb\x17\x16\xcf\x84\xfb\x9ar\x9e+\x12\x95{\x8f\xbb\xd5\xaeP\x9365@@pU\x95\x97.\xfa\x9c\x9d\xc6m.&\xdbG\x85rw/.?\x00w\xf7DU\xfb\xcdS\xfe\xe5\xd2*M\xbd\xed\xd0\xb7\xee[\x02\x93O\x17\x07^\xb0\x82\xc0s\x14\x938\x1an\x89\xdbs\x99Gz\xc3\x88\x9e\xa4\x8avtY\xd4\xd4\xb8o\x9a\x99	Q\xaa\x8b\xe8\xa6 5,\xc3\xc2V\x98\xee\xd5\x1fd\xce\xf2\x9a\x95\x92@L\xf6\x16\x98\xf6\xa02\x81\xef\xbb|=\xf4\xbaq<\x94V\xfe\xfcTag9h\xca\xe0\xea\xac$\x81\xf8*\x0c\xc7m\x14}~\x0dpT\xd5\x95\x8e\xae08\x01\xcc\x92\x84	\xc8v\x8db\x12\xd11\xfb\xb5\xecb<\x1a\x8bL\xad\xb3\x0c#uI\xc7\xf6\x7f\xb9O\n\xfeg\x1c\x0b?6\x1d\xdb1\xee\x8f;\x18\x90\x1d\xfd'\xc3\x9a\xe3\x19\xe9\x07\xc4\x99Z`t\x16\xfe\xf9\x84\xda\x0eY\xd6\x19qi\x0b\x15\x06\xd2\xc9\x15\xdc\xb5W(\xc9!/5h\x9d\xdf\x1eS\x01?\x19\xd9\xae.H<\x13\xd6\xacc2\x07\xb9Y\xdf8\xb8\x85g\x7f[\xbex\xc6`\xde0\x8dZ\xff\xbd-\xce\x98\x1c\xc0\xd8\x02#/\xfaX\x06\xb6\xb1\xe6W]\xd9E\xc6+-\xe1+7(POr\xf7\xe3\xce\xdc\xb5\x81D\xf6\xe7[\xa7\xf4\x14y\xed\x86\xf7\xa6g\x905\x01\x815 s\x17\xd5)Qi\xa8\x0f\x93}\xebW$\xae\xac\xf1\xc2\xedc\xad\x97\x01\xda\xed>[\xf2\x99V\x1bD\xe67\x82x\xf1\x841%\xf6e\xac2\xb1F6\xa6\x8f\xc0a\xd5\xdc	/qn\x99)\x955\x1bL\xb5\xa0^\x04\xa7\xc5n\x11Jq\"\xbfo5\xa3|\x8e\x97\xfc\xd5\xae\x18\x99\xbd\xe4\x13N\xb7\xe2\xcc4\xb6\xef\xd0\xd2\x03\xe1\xa1\xec\x81\x1e\xc8]j'A\xe5q\xf3	\xa7\xbc\x9f\xed%\x19\x0c\xe7\x0b\xd5:_\\\xc1	5t\x97S\xf9\x96\xd76\x9e\x97=\x92Us\x9cQee\xc5\x00\x08O\x08Tw9Z\xd3?\xf0\xcd\xa4\xe9\x1cV!t\xebBG\xbf\x87\xd6N\x0f\xa6>\x83\x8e\xf9\x88\xf8J\xbd>\xd7Ri\x9eD;U\xf1\xf2\x116a\x0c\x0b\xa0\xc4\x8f\xa8\x1b\xb0\xd7t\x15\x83(\xdf\xd1\x8c\xf3z\xebc\"\xb3\xff\x7f\x7f\xc0\x9f\xe6\xa1\x1f\xa2\xeap\x7f\xe1\xb4\xfb\x7f\xc4\x9f~\x82\xbf\xc7\xe9\xe0\x07\xa7\xad\xff\x95\x9c\xaeu\x16\xff+\xa7\xa3\xbf\xc7i,\x89\x0c\xf8\x9fe4cs\x17`\x15\xd9j\xc3\xb2\xe6t\x14m\x8ds~}\xbb\x1c\xb4\xc8\x16\xf7/\x14\xefkQ\x19\xc0\xbco\xdb\x0f\xf2}\xfa\xb1\xdcr,%^u\xf7\xd1\x07\xae\xdf\x043\xdb\x81\xb8m6Bi\x1eaEJ\xcb\x83\xeb\xacri)\x16\x17\x0c\xf7;H\xdf\xads\xf8T\x1c\x1bJx\x81z\xf9g\xd3\x1c\xf9\x83@B\xe4\xc7M\xb8\x8e\xc5'\x03\x9c&zE\xeb\xec4A\x9f\x89Pz<\xa2\xcb6\xde\x93K\xd27YlTS\xd2\xcb\xc9\xed*\x8f\x06=h:h\xb9.\xa6k^>.b\x04,fp\x03L\x11\xd2\x9d8\xa4}\x85O\xc9\xbe\xe1(C\x87i\x90\xbc\xfe\x0c\xaeT\x93\xdc:\x12&\xb3VnS,L;'\x91o1\x8f%\x0c\x96e\xb2Yx\xb7\x96\x0e\xe0I\xb1\xca\xd0\xed\xa3nNV\xa2\xb1z\xc9G\xfa\xf0 \x81\xbf\x9a\xcd\x1at\xec+>\x9a&\x04\xbe\xc35)N\x92\x1f&\x8d\x19\xf9\xc6>\xda\xf1\xc1\xe4\xd8\xbe\x80x\x07\xddY\xcb\x85\x87\xb6\xa2\xc3\x95:\x9a,\xbb\x7f\x94\xd1?\x10\x0d\xfc\xcf\x8c\xf6\xca\xac\x86\xa0\x06\x9d\xcb(K:\x1b?\x8e\xb9=\xde\xf1s\xb4\x16\xcfT?\x83\xfe\x0f0\xfa\x07\xa2\x01\xaf\xed\xadT*\x93t\xf3\xac\x13\xc4\x1d\xb6\x1c\x87\xed\xb6\x98n\x17E\xfb=SE\x91\x8a\x85\xe9\xbc\xebB\xb8\xb7\xa0z\xa5\xec:\x15\xdf\x8c!u\x0f\xfa\xe2C\x07H\xbb\xb0\xfb\x85H\xd0I\x9e\x85\xe6'\x18\xc1\x8aM\xd0\x06\xeab\x95\xa0\x8bX\x84\xae'\x83\xe9\xe9v\xb2Bw\x1f\x96\x06\xc7\xde\xdd&S_\x87c%\xee\xd7\x0c\xf01dU\x16\xfa\x95\x1a\xa1\x86\xd0d\xca\x1d\x8e\x9a\x06\xdbG\xf8\xf1%\xe2\x83\x18\n\xad\x8dV-e\"y\xf8\"\xd0`\xccV\x12q\x94*\x8f7\x19\xa2\xf5\x14\xe8\x97\xbaG\xe0 \xa1\x88\xec5b~3.	\x84\x1eN\x0c\xaa\x82\xac\x8c2\x11.\xb9\xa7R\xa7\xd1Z\x86\xba\xe5m\xa2?\x93\xb4\x7fjs\xb2\x9e8\x9f_o \xe7\x87\xd2d\\%i+C\xb7[\xda\xd2LU\xfb\xb6\xb0\xe2=0\x0b\xb5\xe3\xe7\xa5\xb1[\x08\xc7]\x12\xa8\x04.\xeb)\x15\"\xc3y\xd4\xaeN\xa5:\xb3\x81\x80\x80\xb9\x051\xb8\xa0+\x9fajr\xf2\xd9\x98B\xc0\xfc\xb9\x8c\xfe\xbf\xe1M\x1b<\xfb{F\xff(\xfe{2\xfa\x89]d\x88\xff5F\xfb@\x84\x9b\xecd\x13\x17Czo\x95iEA!\x07*\xf3\xc3n\x0eT6\x85\x82L/8\xce\xdd\xd0\x16-Lm\\\xfd4:\xd0\xf6B\x9f\xdcR\xde\x1d6$\x80\xa4\xf7X\x01\xa1\xcf\xd7\xd3\xa0\\kr\xf1S\x9e\xb2\xcf\xa6\xa3W\x06r!\x8c\xf7\xd4\xe8v:\xae\xc6\xd8\xeb\xf8\xb2\xc7\x8f\x98\x7f\x05\xffD<\xe9\x92=|	\x00\x9b\xac]\x83\x02!F-O\xfb{\xcb\xe5%\xcf/\x1e\x06\xdf\xbc\x01CaJ\x8f\xce\x88\xb7\x17\x17\xcb(w\xc7\xb1jhi\xb2\xd3\xa0\x90T\xc6\x01Z\x96\x8b\x03\xaf\x96\x85\xbdP\x86\xf9\xda\xee\xb3Pg+\xce\xa9\xe7\xe1\xfdnw&\xe9\x87\x04/\xbe\xc5 \xb0.U~\x0d\x04\xa2\xf6\x9d\xbd|\x8dt\xbf}Y\xba\x17\xf6\xb0\x03p\x9e\x18;Dk\x8ee_\xcf~\xc3\x0c\xe88\xf7\x96\x06\xb7\x98}\xf7\x85.\xe6QLy-\xe1\x16}\xe4E\xc6\x80\xa6\xfdB\x11\xe2\x1a\xed\xe2\xe3\xee\xa0\x86\x89@\x1e\x15\x92\x1d\xcb\x17\xb1\xe2\x08\xcc1\xf9 M\xd9\x9f\xc9h\xb2\x0d\xc2i\x84\x8e\xb4w\xc1]\x88\xd0\xf1Q\xaa\x13|\xa9o\xf8\xf9\xb3\x18-\x8e<\x0f\xda\x07\xdf\x0f\xd1\x84\n\xb5\xdb\x9c\xf6\x15 \x18\xb8_\x96s\x04\x85\xe9~\x8e]nf\xae\xd6O1\xe4\xf4\xdd\xb2v\x96\xd16R\xb3bJ:\xbe'\xc0/\xea\x9c\xb8e\xc3\xb7f\xb5\xd5z\xfb\xe9\xcc\xb0\xfbV}\x19\xba\xc6W\xab\xba\xd0\x0b\xa62\xa2\x96\x84\xa7C\"\xb4\xa6\x8dg\xbf^\xc6I\xe53\x82\xdf\x17\xf5\x00\x19\x98\xe8h\xa2\xcf\xf4k\x84\xce^\xcf\x15\x81\xa9\x0bWk\x07\x07\xad\x8e\xf3\xf5y\xf7L\xd7\xc4b\xb5\xc1\x0c\xb5\xf3uBO\xb3v\xd6\xf2w\x16\xf0b\xfb(0\xa0%q>\x93\xfc`A\xd9\x87q+\xd9\xb3Rt9\xd8\xb7A\xeb\xb9\x86}\xc1\x06VSw]G\xc2\x99Q\xb6!\xc8=\xe9\xed\xe6\xc5\x8474\xb6\x07\x7fh\x00\x066\x86\x10\x14\xd7\xbc]C\xaf#\x9e\x95\"\xbc\xd4\xc1\xc2\xf3h\xf0=\x97\xe5':dT\x8e\xa8\xf7\xc0}>WEBg\xa1M\x9d\x83\xa0\x96\xfey\xdb8\x03,\xdc\xda\xe7\xe1\xf9\xa2\xf1\x87\xcc\x02Zg\xbeZI\xfe\xb9\x8c\xb6\xff\x0f\xf8\x1d\x9e\x0f\xeb\xfco}\xe9\xbf\x14\xff=\x19\x8d\xe4\xf7\xbf\xcah^\x01|\xe58\x9b\x97ql\x9a\xdc#\xeejE\xb9E\x99\xeb\x15c.\xa4\xa3\xc9m\x87<vRl\xd56\xcb}\xdfP\x95#'\x0cm\x87\xb2DX\x9f\xe9\x0d\x13\x00\xa8\xe0\x9euY\xa0\x10\xfa\xb8\x17\xbe\x04S\xb7@8\x8d}\xf0\xe1h\xdc\xd5\x8e\x9f\xcfA\xbd\xbc\xc3\xda\xe9\xacQ\x15\x95@\xc2\xe8\x98\x92\x10u\xc3\xbe\xdd\xdc\xb4\x02|7\xf6\x9f\xc0\xa0\xcb\xc7\x81\xb2.\xf2\x8e\xd1\xf5\xed\xf4\xad\xcc\x87\xbac\xa6\xfc\x8epz\x031\x83\xc9\xfc\xdbT^B\xf1\xadY;\xe4\xc9\x97\x8c\x94\xcb\xc6\xc1\x13\xc0)\xc4\x06.J\xbf\xdffB\xd09\xces\xfc\xdb\xce#\xe1c\x83\xbb\x91\xf1\x1c\x08\xce\xf9\x8e0F\x01\xad\xaf\x85\xf6\x9dy\x8aO\x89\x93L\xf6\xa6\xb6\x8c\x8bW\x80.\x03\xb9A\x94\x1b\xd5A\xd7\x88\x8a\"0\xa5\x93\x10\x8e\xcf\xf1\x95gN\xb1o\xf3!\xde\x0f\xfe\xb8G\xc2\xd0\x85\x93}\xaag7\xcc\xa5|8#>HC\xd3\x81	l\x9e\xfc[\xb4\xf7\xf5\xa5\xa8\xc3\x18_Bz\xfd\x89\x8c\xb6][\xc2\xbcQ/I7\xc5\xb4\xa3J-6\xa5\xd0/n\x8b\xfc\x9f\xc6\xe874\x9aI\xc5b\xc8\x8b\xba\xbc\xb13e}5P	\x9d]Mf\xb5\xd6~\xeb\xf4\xf1}\x814\xb5z`\xe4\x80^\xb0\xb1\x1e\x99a\xf7l-:#52\x03X95]\x8cH\xd4\xd6r<\xe9~\xc1\xa6\xf7\xb6\xf21>rq\xacV\x0fBV\x86\x08\x87\x9a\x9btc\xcf\x9f\xdb\xd0e\x9a\x1a4\xd13\x02\xca\xe8\xf6\xb2\x07\xbaPx\x18\xc1vo!\xfc\xf4\xc6b\x0c\x1c\xde\x91\xbd\x1e\xdf\xd6\x13Dv\x19\xf9e\xe2\xd5*-\x1aS\x90\xac\xe3\xb7\xea\xda\x055PtOO\xbd\xfbp\x13\x01P\x9a\x01=\x06I\x08g\x8c\xce&\x1dm\xa9w\x9e\xe1\xc8\x1e\x98\xf1\xe1\x0b={\xd4)\xbb\xe60\x17\xc3\x0ew\xd83\x9d\x1c$\xeal30\x1a\xfe\x1c9s\x00 D\xd8\x11,{q\xd6\xdd\x91\xb4\xc5\xbdX3\xf0\xc9\xbc\xf2\xdc\xeb\x15\xfa\xc1\x0d\xaf\xdf\xd8\xd4d#\xa6\xd95%X~R\xde\x98\x12\x8c~_z\x83\x8e\x8c;PN\xcer\xb3\x1d\xc8\xf6\xf4\xa7\x81\x14\xaeh\x1dm\xfcs\x19\xed\xfe\xdfxvh\xf0\x7f\xc7\xeb\xf8Q\xfc\xf7e\xf4\xbf\xe8u\xf0\x02\xc07'x\x9c\xd0\x9d\xa7\xad\xd24\xf7\x81.\x91\xe8\xb4\xe3\n\xf3\x03\xfb\xca\x8d])_M\xb1N\x85\xfc\xf2\x02\xc8\x13?Cg\xd4\xce\xcb.\x1e\xb3N\xf6\x84\xe6\x80\xe7\xb1Q\xc9Y\x94\xc9\x80VU$\xb7A(\xc8\xd3\x04I/\xe5Y\xbb\xb7b\x10\xa6\xbc\xc8\xd8	\xd42E\xbf)\x82\xbeQ#y\x11P\xd6\xa5\xa2\x89T)\x80rD\xc6,%DI\xc6\x1c\xe5\xf9\x1d\xdc\x1c\x84\xad\xa3\xc7_\xe5m\xb0\xa5J\x8c\x0d\x17\x86t\xd6\xc4-\xf0N\xc9\x90R\x9a\xcf\xc1\"k\x95\xe2r\x1b\xdb.Pd%\xae\x7f\xa5p\xfa\xc2\xfes2k\xe1P\xa6\xf8\x02_\x10\x91\x13\xaf8J\x94\x883\xe9O\x1b\x8b\xe8:\x90\x9a\xe1\xbe\x89\xfc\xe6\xad<\xd6\x88@\x83A\x9b\x06\x02\x85\xfc\x1e\xaa\xec\xeaq.R\xe7\xbb\xb4\xceW\xb7\xb3\xfd\xbe2\xa2\x00\x9dY\x91\xb4\x8a\xdc$_\xe3n\x8d\xd0\xb2\x0cO\xf2G;KU\xd0{lA\xe67\x02x\x95\x13\x08\x85\x94\xaaM\xd6\xcd\x9a\xfdu	\xc6\x9f\xa9\xa3]x:\xa1]t\xe8\x8c\xeb\xea5\xf4,\xb5\xbfo|6\xf9W\xf6\xa71\x9a\x8b$&\xc6\xaa\x92I{r\x90\x8b\xb5#\xc18\xecn\xf2\xbe\xfd\xe9\xadaT\xe6\\	\xbf\xdd|\x10},\xc7N#\xe7\xb1\xbc\xe7\xa2\xe8\xee\xcb\x9fB\x06\xc9\x80\x0de\x85M=\xb6\x13\xaej\x9e\xb5_{8\x9b\xc3R\xf1>\xb4\xfc\xa4vwL\xf5\xfdc\xed\xf5\x8d\x7f\xb6o\x07e\xa7\xd2\x0d\x1fA\xe4\xcdB\x96\x1axl\x812P\xa6Z\x14:?\xea\xf4\x06$R\xd9\x12D\x9a\x1d\xec\x89}\xbd\xab`\xd7:\xe4U\"\x84\xcf{\x91\x98\x82\x97j\x89\x97\xefP'\xffp\xd5\x8c\x87\n2\x02\x9b\xafK\xf8\x8a\x88\xbd\xc4$\xf56\xdf\xa2\x95T\xe2K	\xbe5wH\x85\xf3z08\x08\xb5~\x10\xaf}\x1f4\xb8\x94\xde\x83\xc5\xdc\x8a	s\xf6e\xd9\xc3\x0cl\xa9\xa1~\xe4\n\xdf\xdff\x9d(a\x0f\xbe\x17\xbe1\nZ\xd8	\xe1\xf9\xaaX\xea;\xfe\xa6\xc5\xf8\xa2\xb8\x96%\x12a\xf1\xd5iS \xe8LpN_\xf8\x14\xc0t\x98\x87\x1d\xdae\x99\x16z^&Sz8\x8f\xfa'\xfb\xd1\xff\x07t\xf4\x0f\x1c{\x7f\xcf\xeb\xf0\xfe]\xbd\x8e_1Z\xf9g\x19\xad\x032\xf1r\x1d\xfc\xe5\x93\xdd-8\x1c\xf2\x8d\xbd\xe9\xc80|\x1b+B\xb0\x83\xe8~Ro\xdc\x96P\n\xa5\xc7\xc3\x10\xe3m$\x92\xcb\x8biJ\xddeE\xf7\x85	\x8c\x8f\xba\xd5e\xe6\x10{\xb4\x92\xef\xe2\x1c\x949\xec\xb5\xd8\xa6\xee\xdcO\xd3b\x0f\xb4 .\x14\x1c\xb6s\x05\xcb\xe5	^\xdf\xe2P\xa3-\x1b\x8d\xe1\x02\xb1`\x0b$\x18\x1e\x1c\xc1\xc05\x18\xe2wD86);?\xaf\x1d\x92\x9c\xb5A\x02(\xe5\xb6\n\x0bs\xb2\xe3\xcaG\xc6\xec\xea\xe0:vy\xae\xc24e\x83TJ\xf4\x0b\\\x11L\x9b\xc4:&\xdeW\x9f\x1d\x96\xf9Z\xfdU\xa4V\x0d\xe9-U]G\x19z\x99\x10\xf4\xd4\xab?\xc4LD:9>_>\xc2\xbe\x95\xb7\xc24\xf9:\xac\xc0\x85\x1f\xafi\xa0\xb0\x1d3\xb1\x01\xdaf\xc3\xcf\x95\xd3\xe75\x18rA\xadZ\x9bs\xa2o\xfb\xb6\x86&\xc3\xc3\x1b[\xaa)y\xabE\x7f\xcbC\xd1\xef\x93\x9d~\x01\xdac\xe9\x83\xd2d\xe2\xdd\xc3)\x13o\x83\xfe\xfe3u\xb4\xf7\xfa\xcc:>8\xd3 \xca\xa1n\x85\xce\x03?\xfd)&s\x8dt\x7f\x16\xa3\xd5\xf3s\x9d\xf59Z{^\xa4>eR!_\xc4)\xde,\x9f\xc5\xd4\xd3Q\xc1i\x83\x7fF\x01\xde\x10x\x96>\xeb\xf8>\x93\xbb\xceC\x1f\xee<\xb0R\xc1\x18X\xd4\x129a.\xa8\xb4\xb4|\xc7\xc6f\xb2A\x88Exb\x8dU\x15W!\xdb\x94I\x9b\xb6B5\xac\x90E\xee\x14$\xd4/\xa4N\xb5xJ\xa0\x0b\xe1\xab\x14\xd8\xfc\xb8\x7f\xac/\xd7G%}\n\x0f\xf1\x14\xbegr\xcb\xce\xef/\xfa\xc3:4\xb7\xedo\xfa+Pa\xec\xd7m\x10\x8c\xacZUa&\xdb\xef\xbd\xea\xbe\xa4)\x02\x86\x9e\xc1\xb6.\xd0/\xa4\x97\x9c'G\xc3\xa6\xb6($v\xd1F.\x90>\xda\xb79\xb6\x90\xdb;\xf0\xaf\xd7\xb8\xda6\x1bx\xd6\xb7\xf2\x96rH\xee\xf4\xef\xf9\x06\xe0Jd\xf0sa\xb1p\x98u\xe1\xcb\xf1\xa9\xff\xfd\xca\xc8;\x19\xd8\xc83\x86\xb3\xa5\xb5=?y|\xe7\xaf\xbegw|\xb7\xf3G{K\x8b\x0dM\"\x14\xd2\x13\xf0\x8a\xf9\xb0\xc7\x14%G\xb6\x02\xce\xfad\x9d\xd3\x03\xfdC\x8c\xfe]\x06\xcf\xfbO~f(\\:\x1f\x9f:\xcf \xc6ofZ~)\xfe\xfbf\xf0\xfev\xa6\xe5\x9f\xce\xe0i\xeeY\x80N\xe0?\x9e#\xc3b=e\xe7\xeci/O\x1f\xa1G\xf7p\x10e\xbf\xcc{a\xfc\x92\x8c\xa3\xaco\xb7\x1a\xad\xe7;\x0f\x8f#|\xd7@g.\"\xcbb\xd2~\x7f\xa3d\xea\xd7\x96\xd2{>\xf4\xc7\x0eI\xd1\xbez\x0d\xe8\xfc\xbe\xe74\x01\xe5\x91\xbe\xa0-\x00\x15\xf51W\xeb\xdb!Q\xe0K\x9dE\x02w\x1cO\x96:\xfa\xf1\xfaMJ\x85\xaa\x84^\x91\n\xb1\xb7\xd0\x11\x87\xb6zs\xa5M\xfc.<-jH\xf6F\xb4\x0b\xf5\xf9%\x8e\xe0^\xf6\xf6\x0f\xf2JF\x08@f\x83l?k\xc8\xbc\xdd1\xd2\xf9\xbb\xc8^\x8d\x00\x8b,\xca \x81x\x97\x9a@Z\xd3\xccwPL\xe4\x96|ZV!\xb4$\x84\xd5=\xdf\xbb\xa9)a0\xf0	K\xbbzc\x8b\x99k\xeb\x93\xc9i\xe8]\xfa\xd2\x140\xef\xbe\xa1\xf5HH\xdc\xf8\x80\xef\x9b$l\xc2rr\xef\xb3\x7f\x08\xa2\xecM\xffi\x8f5:{p\x06h{a8\xfd\xccU\xca1W\xf3\x99\x12o\xf5\x1eW\x0c\"Q\xf8\x133x:i\xcc\x17d\x87Y\xf3\x84\xc1\xf7\x99U\xe8\x86{i1,\x0d\xfc\xb32x\x9c\xd7\x87\xecj|o\xa7\xc2b(D\x11\xfe\x14/l\xf6 F	?\xf2\x0e\xb3\x85\x94\x98\x18\xbe\xa7\xdfc\x1c\xa3r\xf4d\xfa\x9d@\xe3\xfa\xa5\x81t\xe8v29\xf8\xbd\"x\xc1\xee\x8a\x8d9#}\xbbg\x18\xab\xaa\xc9\xa2\x8a9\x1c_m*\"\xa0~\xb4\x145\xef\xb07z\x9b\x05\x15\xe5-\x9dG\xffU\x81\xbd\xbf\xa2W\x96t|\x03\n\xa9\x91\x1e\xa1\xf6\x01\xb9x\x9aZ\xe3\xe2\xecw%\xeeQ\xacn\x95Y\x04\xec\xa3a\x97\xf1\x06\x13\xec\xc8M\xdbi>hS\x0ea\x0ep\xbc]\x9fUc! \xb68\x81\xb6\xca\xdf\x90\xb38\xdc\xc1*\x8fr\x12\xf9p\xc6\x04{\xf5S\xf1\xc5)R\xba\x9ci/\xbb\x06,\xad\xaaj+\xea\x95\x89\n\x80}\x94\x84\xb3Xi\xce>\x1f_\xbf\xf3\xb4r\xb3;\xda\xcbY\xcaS\xa8g@HY?\x8c5\xf4\xf0\x12\xdax*\xbc\xe1\xdb\x0b\x0d\xb4A\x8b\xa9\x9byj\x8e\x80\xecV\x8e7T\xd3\x8c\xe444\x05n\xdbD\xf1d\xc6;\x96\x97\x85\xff:\xa7y\xe8'N\x87\xff\xc5\xe9\xdf=7\xdc\xfec8\xdd1\x88\xce\xeb\xb7\xee)\x84\xde\xa9\xbf\xf5\xa4\x7f.\xea\x8ad\xff\xaf\xe4t\xf3\x97m\xf8\x83\xd3\xf1\x7f\x9f\x95\xe6\xe0\xeb\x9f\x9d\x0d\x07`\xb2\x0c\xa5\xb7\x00{\xe5\xfa\xa6\xfb;4\xdf\xbd\xfbZ\xe1*2i\xea\x01\xaf\x9b\x0d0^sYt\xa8Q\xa9\xaf\xd9\x07c\xe9\x97\xde\x8f}\xc9\xc2nrc\x12\xc0\x95\xf8\xc1-\x9c\x95\x89Z+\nE\xeb\xe7\x8394\xc9\xf6a\x0f\xaeL\xdb\xf1z\xeb\x98R\xa3\xe7QN\xc2`B\x94\xb7\xd6i,\xc7~\xe6a\x05\x18\xd8! \xf9\xd9{\"$'Q\x93\x121\x19n\x88\x0b3\x1c)\x06\xe1\xb4\xe5\x98\xc1<\x08\x90\xef\x95\xc5\xe8$\xf9\xb6\xabZK0\xa1\x9bG\xd7\xd3\xf9\xed\n\x81\x84}@\x95b\x1fr\xde\x9c7\xbe\x9e1a\xbfC\x98\x1aJ\xa9\xf1\xd3\xa7Tr\xfc\xa3Md\xedO*\x9a\xdb\x13\xf2\xb9\xb9`(\x85\xf2\xce\xb1\xab\x8by\xf7\xd3\xd5\xc5\x04\x88\xefX\xca\x17z\\\x9a5\xf0\xe0\xec.m\xdd\xec\xe1\x9cr\xe4\xe5\xfap.\x03\x07D@B\xdc\xa7\x14\x03\xf4\xbd\x97\x1a\x01\xaf9o?\xbc\xab#\xafohA\xc0\x02\xd3\xe2l\xbcL\xca\xfe\xecf\x99\xa8\x8f\x0c\x0d\xe3/\xb3\xe1\xf1\x99\xf0\x7f\x7f6\\\xf8\x99\xdb\x1e\xf03\xb8\xeb\x93\xad\x7f\xb0Za\x19\xfeo\xe7\xc3\xd1\xf6\x85\xd9\xf2!\x0cP\x01\xf7e$\x82$-\x1b\xea\xd2P\xf9\xcf\xf3\xe1\xc0\xaf\x07\xc4\xff\x89\xf9\xf0\xf7_\xe7\xc3\x01\x86['\x19\x0d:f\x99\xf9uvm\xb3\xbf	\xd4f\xcfMJ;\xf0\nc9$\xa4\x91\x12\xbe\xdc\xfa\xb5\x8f&\xd4\xfb\x92=x2VG\xfdk\xdc\x0c-\x01\n:4$4\x83\x9b\xdfC\x8e\xb2eqvYN\xd1\x18\x96g\xd6\xbcC?\x9b\xe1\x9c\xe4z\xa5\xf2\xb3\xaf\x10'p\xe0x\xf1\xdc;\xc5L\xa4\x91\xd9\x94\x0f\x00vd\xb1\x89K2\x1ac\xbf&,\xd8\xae\xd0S\xe9\xbdk+T\x81[w_\xab\x99o\xd8\x8b\xa8\xdbi/d\xc9\xa7\x0f\x9d\xaa\xbdD\x9e|\xfa\xcb\xde\xea6\x02\x90\xf7\x86)\xbd]I*\x82\x8d\xa1Q\xe6\x19\xeb\x98\x1e\xbc\xe4\xb4\xd4\xd4\xe2*4\xc6\xb3\x17\x18\xc7_\x16\x91\xc7(\xbc\xe2\xd9+\xd5{d?s#\xd5\xbf\x9c\x05\x14\x12\xae\x13\xc5\x9c\xce>\xf32m\x08>\xfa\xf7;-\xa17{\xbdK\xef\x84\xf1\x15\x81R\xd4\xbd\xc54\xf0#\xd4;\xb3\x0cq\xb1+\x08\xcf7\xf3\xfan\xb7\xf4\x01\xda:\xba(\x96\x90j\x0bT\xe4\x8c\xe4\xbd\xceR\x99\x16\xfe\x03Z\xfaw~\x87\xf7\xbb\x8d\xfd\x9f\xc4h\\\xf7\x84\xcb\xf4|\xfc\xb7\x9e\xf4/\xc5\x7f_\xbf\xe3/\xcf\x0d\xffY\xbf\x83[\x80p\x9a\xfc\x88:u\xf8y\xb6=\xcf#\x10o#MMi\xc9\x81S\xd4Z\xbe,\xb2\xa4B\x84\xdb\x0eWW\x8dP\xd8!\x9f\xba\xeb\xfd	\x8d\xba\x088\xad\xdf\x01$\xb5\xfb\xf0 :2`84R$\xaa\xd7\xe6y\x1a\x91\xa4`\x83\x9b}\xaf!1D\xc6}\x0ei\xeb\xd3w\xa3\xf6\xe7\xfcb\x1d\x102\xd7Z\x00\xad\xf7\x0e\x01\x82\x89\x8c\x83\x01[\xf6Qt)\x14\xf5\xc2\xc5E\x9e\x89\xd2\x9e\x84v\x94\x08\x99T3_\xe4\xd7\xbbeC\xcea\xf9\xf5z\\\x149\xac\x83\x15\xc0\xae\xfd\x06\xad\x0b\xdcO\x07\xb1\xfd=\xdb/\xa2\x99\xfa\xa3\xdc\xb0\x8av\xc8\x9a\x93\x8a\x1cT	D\xcd\x92i\xac\x1f\xbf\xffF\x1d#\x1cZ\xc3\x8c\xb4\xdao\x81y\xe5\xa6\xe6\xc7%\x01\x08N3\xc1>\xfdt\xcfRe\x8c\xe2)\xfd1\xd0T\x10\xa4\x84rm\xbd(z\xb2b\xa9\xd9\xd7\xd1d\xb5oE\xf2\xf4\xd4\xf3\x84\xf6\x9c>G\xb0r9\x15\x01@>\xb3=)\xa9{\x1b\xcb\xd4\xcf\xea\x18(\x0d\xfd\x89~\x87\xcb\xc8\xf6\xe3\xc7q\x92\x95\xcb\xa9	\xfaW*\xe4\xe5u>\xf2T\xfd\xb1\xfc\xdd\xf6_~\x87\x9e\xa5\xc2\xe3\xc6	\x98&\xa5\xc7^\xd5\xebn\xe1=}i\xb8S\x9c\x82\xba	\xa3E*\x8d(\xdar\xec\xef\xdf\x14\xe5\xeei\xc0mzOj\xcc\xd1\xb7;\xf3\x00z\x1c&\xd7\xb6\x87\xcc\x80\xceUQ\xa2\xac\xff\xe4\xb5\x98\xcbs$\xd1f1B\x19\xbf\xfb\xe7y\xf2i+4QP\x95\xf6<b\x12E\xd9\xb2\x19\xea\x1e'i\xc0\x9a\x16\xe7\xd1\x10\xe3\xf6\xc9\x10J-\xa2d\x8e>\xb8\x15\xcb\xbcfQ\x1dl\x1a\x15s\x8b\x86\xe0\xa0\xe0Q\xe6\xd9 W\x8e\xbb\x04+\xe1\x97\"\xf6&\x135{\x01\x95{B\xd2\xce\x04d:\x81\x10\xfc\x01\xed\x11\xb4Z\x05\x95\x89\xe8Hpit\xd4x\x92\xf4f\x0d\x1b6\xfe\xc4\xd4\x82u{0i\xf9\xd6`\xf4YW\xfe\x14\x02\xcc7\x11\x12	\xba\x1b\xf1f\x92\x10\x16\xb6-\xc5\xe8w\xfb&\x02wE\xf0\x18\xe1\xcd\x0e>\xeb\xa9qc\x88\x0e\xe5+efDf\x11\x8f6\xcb\xa4\x9f\xe2\x8c\x07\xaa\x01\x12$7\x81=\xe1\x9b\xf4\x82\xf1\x89rk\xf8\xa3\xf9\xbb\xdf\xcc\xb2x\x7f\xef\"\xfe\xc70\x9a\xd0=\xff\xd4y\xe1\xd4=\xf1\xb7~\xc7\xcf\xc5\x7f\xcfY\x96.\xfec\xb3,\\\x12+Pk\x93\xfc\xc7\xc5\xa6\x1b\xdb\x11\xe1\x8c\x90\x93\xe1A\xec\xad\xd7\x0d%\x160\"\x84\xc4[9R\x0d\xedcE\xb1\x97-\xd0\x9a\xeb\xcd\xb5;\x14\x10\x00#\xe5\x10\xb2\xb6\xaa\x8b\xd8\x0fm\xf2o\xaf\x81\x8d\x94\xcc\x92\x90\x14\xd2\xdey\x0eY}\x97\x18\xaa\xa9\xfb,\xc6\x16G%0\xed\xafO\xf4Y\x18(z\x83H\xa3\x03\x16\x9c\x8c\\\xb8\xf9\x941A\xc2\xf8\xad\xd8\x85~\"\xacH\x16\xd2\"\x19\xef\xf3\xe5\x8f8\x0d\xc4\x0f\xe82T\xeaab\xb4\xb0\x9fye]\xa4\xba\x1f\xdf\xda\xc0\x10\xc8\xc9\xc7sH\xf0\xce\xf1\xa7\x97#8\xb2/\x8epd\xf2\xea\xea\xa5t\xfa\xd9L;\x06\xda\x8c\x99bl\x91\xd2\x86p\xd4\xe4\xac\x08D\x1c\xcf\xbf_\xa6q\xb4\x04\x88\xd29\xd8 \x0e\x97\xba>e\x04\xcb\xe3\xb2y\x89\x8b\xe6\xa9A\xed}\xd1%\x8b\xb2\xf6\x14\xbf\x9d\x8fb\xda\xc9\xeeT\x99\xad\xa0F\xd6Q\x0b\x16l\xcea\x86\x08\xb0\x0fy\xbf\xa1'\"ep\xcc\x91'\xe9\x1e\x8a.\xf6\x03\xf2\x8fr\xfa\x1f\x98e\xf1\xe68\xbc\x04dH\xcf\x81\xd46\xd2\xd8\xbeK&\xdb\x98\xa4	\xfb\xf3\x87fY\xb6\xff\x9ae\x91\xa0\xd7n\xc3\xf3\xdcb\xcdl/)!\xa8\x0f\x0c	D\x10\xb3\x1b\xf3`\xf1\xc5\xa0\xf2\xac\xcc\xd3\x04w\xea-!d\xf6\xb0\xf8\x9d\xec\xf8\xd7\xb0u\x06\xd06c\xb0\x91\xe3\xe4\xc3\xf8\x8dL\x0f\x89\xa5W[\xfa\xbe\xa8\\\xeb5 \xdaxV\xcd6\xbe\xeb\x04\x96\x0bt\x9c<K\x19P\xf9\xc4\x8e\x8e\x00\xcf\xa2\x96\xd8\x1aH\xeb\x89I\xe0,\xed66\xaey4\x90\xecl\xed\x8a\x1e\xb5'J\xecn\xec-\x84\xcaWJ.\\\xbb\x13\x9a6U\xd4\xbeXJ5\xad\"=|\x87\xd9C\x06\xe0\xb5\xb8\xb7\xf0\xf7[\xbd\xf6\x0e\xa6x\xf7\x91^\xfd\xe1\x84\x19\xcd\xe2\xd4\xb1\xfa<Xj\xbcrwU\x97M\x8f\xb4\x17\xb4\xbc\xaeO,\xa6\x07%D\x98\x1c\xf04@\xdb\xcd\xd4\x0dT\xe6%d\\1B\x82M2\xbacA\x0e?\x14\x19UR\x83S\x9f\x02^\x0c\xa7\xd1\x03\xf0Z\xe6\x9e\"\xd2M\xab\xa0\xc1h\x881\xf7?\x1f \xd0\xcej\xb8\xf5\xde^\xdc\x8c\xe7\xc6\xbcPi\xd8@/!\xfaC\xbe\xf4\xef\xb4\xb4\xff;_\xfa?\x88\xd3?P\xcc\\\xc6c\xc3\x06\xff\xb7~\xc7/\xc5\x7fO-\xfd\xabY\x96\x7fVK\xf3&\x90\xef\xfb[\xad\x8e\x8c\xc5#]W\xa4\xd0\xa8T\xc5\xed\x95\x82\xd3%\xf56\x9fw\xab\xabi\x1b\xb2\x84\x14\xcf\x91\xd6\x08\x03\x15K\xf3\xbe\xdc\xf0\xda\x0fg\x88\xb16\x90\xc2\xd5\x18\x149\xf7\x8e\xc0B9\x02\x9bnE\x0dLr\x8dz\xce\x91\x92\xf7\x82\xf78\xd9\xe4\xb4\xd2lP%\x9f|<\x87\x02e\xee\xc5\x96\x94\xa6\xd7Q\xe8$`@IK\xe8\x84)-\xc1\xf9\x0e\x98\xb7\xedSq\xbeo\x13\x96\xd3D9\x90z\x11\\\xe4\xa8\xb6\x8a\x15\x1c\xd4\xce\x05\xc9\xf4z%h?\xb36Z\xaf\xb4\x85\x9b\x80\x9b\xa18\xed\xca\xe2jf06\xc4\xfc\x96\xae7b\xf0\xdb3	f\x93:\xecI\xf1\x96*.\xdf\xf1D\x06\x06\x83br\xfd\x08\x97\xa3\xc0\xa4\x8c\x06\xb1\x83\xb6\x00\xb5^'\x1f\x10\xb0A\xbc\xaa\x0d\x81'*.\x11\xc5\xbc\xbc@\xddj\x81\xa1\"R\xe8\x1d'Z\xe3T\xc7\xe8\x84\"\x9d\x17\x8d\x13\xf8+\x94\xab3\n;\x7fk\x01]\x88`\xc7\x85\xa1\x1d\x91\xc6\xc9\xb7\xbbo\xf9\xe5\xffQF\xff\x03Z\xdaf\xaf1?\x1c\xf7\x12\x9c\x98\xdb\x8d\xc9\xfd\x10\x97Q\xdd\xee\xdc\xcb\x7f\xec\xd9\xe1\xaf\xb4\xb4\x7fs\xbe\xddm\"6\xf9\x0c\xc3v\x95\x90\x8cK\xbfy\x87?\xc3?\xfe<jR\xbc\x83\x82\xae\x8bE\xd9M\xac\x0d\x83\xe8\xa0\xaa\xa2\xb4{ \xc73=\xe2\xf2\x80\xe3\xd6\x95\xc0\xce\x13\xf1\xfdj\xc2>L^E\xa7\xd8\xf2}\x85\x91\xc9\xf4MuM\x9f\xfbC\x8b2i\xb4\xeck\xce\x14W6l\xc3\x92'x\xe8<\x14\xf2@\x05\x88\xc9{\xaf\x9c\xaf&\xa7p~`\x01lD\x99:\xa2,\xae	\xe6\x8e\xdb\x1c\xdf\x8a\x97\xafD\xf4C\x96,\xae\x15\xed~\x1e0a\xd6vCj\xf9\\\x9b\xad\x05\xa8\xe5\x16^\xac\x1eE\xd2\xccs\xc8G\x89=\xd1\xa4\xb6\xe7\xe4oS\x1e\xb8<\x88T\xde\xd8<\xb5\xc91t\xe6\xc3\xb8(\x95,\xa4f\x88P\xc0\xd4\xde>Q\x02p4)\xdf}\x92\xd7\xe1\xf3\x90\x9b0|\xec\xcbO=L\x99\xf5\x13\"\xa6\xf1\xc4\x8fP\xd3\xa6d\xbaj\x85\xceb\xbcZ\xecS/\xd9\x84&[\xb9\x12\xaf\xaf\x0c\x8ck%\x08\x07G\x1d\xfel\xcdUT\xb0\x0bj\xfe\xc9\xf3\x86\xfe\x7f\xb0'\xfd0\xb8\xde\xf9\xb7\xee\xe9\x84\xde\xfd\xf6\xf3\xf0\xfeR\xfc\xb7g\xb4\xfeO2\x9a\x03\x81k\x1c\x04\x0e\xc2R<We\xd0\xb7\xce\x88\x13`t\xe0\xa8d%\x0c\xb9\x80\x8b\x89\\J\xbd|\x1b\xaf\xb4\xce\xc8.\xc4\x83\xca\x8da\xe3.:\xa7Cy5y\x01\x1au\x06\xe4vd\x84\xe1K\x1eb\x1c\xe2\xf8u\xc7\"3\x1c\xd4#h-\x82\x17\xb4\x08\xbe\xd7\x18\xc9\xf2\xae,6\x1d\xbf\x98\x15K\xaaf\xe4\x88\x95`\xd7\xfa\x04v\x9dA\xae\xf4\xe6[J\x1eg+\xc2\x9cW\xdf\xd3\xfd\xce$vE\x0e|\xd9o\xdcw\xee_m\xd1y\x07\xbd\xb7\xee\xc2\xb7\xfaYl\x04\xdb\xe9[X\xdd]\x02\x14\x08fy\x92d\xc8qPX$\x8d\xb2\xee\x1d=\xae\x85\x04\xdd\x0b\x9fTm\xca\xdb\xf0\xb53\xeb\xa9\xb4i\xf1)\xd5\x9d\x98\xa3\xfb\xc3E\xed\xab\xec}}{\xc1@\xee{\x874b)\x83\xd9\x94\xc5\x12C\xa7\xea\xd6\xfa\xc0eP\x14i\xab|GO\xa5MLx0\xfe\x80\xe4E\x19o\xfd\x89\xba^L+p6\xa2\n\x92/\x00\x12B\xa2\xe3\x1c\x87\xfc\nm\xa4\xe6\xbc\xc3\xbb\xd4\x9f\xc8h'\xa1Ggw\x89J\xee\xb1Dk$\x146\xd3\xbc\xc7sf\xd5\xbd?\x8b\xd1\"t@\xf4\x9b\x14.\xfb\xe4)L\x84\xb2\x86;)+\xcc\xcfs\xdd4d\xbc\x17\xa5\xf8t\x85\xb2\x1d\xb0\xea\xd6gxJ\\4~\x94ma\xb0	\xe3\xd7k\x056\xbdaT\xfe\xf5\xdaha\xa40\x8c\xda\xc1)M?hp\xbfM\x12\xd3\xa5\xb3\xeb\xc0/\x83\x8f\xfb\xd7v\xb4\xf5\xe5\xe6\xca\xd2\n9u\x19d\xfd\x92}}+\x80\xda\x92\xe8\x81\x95*\xa3;\x98d\xfbHj5|\xfa\x19\x97\xef\xc3\xd5\xa9G\x16.\xbd\x8b\xde2\xc8E\xf9\x17\x1a\xb1!4\x93sR\xbeC\xa0\xa7\xf8\x05{\x0f\x0c0x\xd5\x0fiz~\xee\xa9~J\xa1;B\xf21\xdb\x98{\xbad\xb7\x06\xeb%\x9e\xb0\xbb<\xcb\xa2\xbe\x82\xc5\xae>	E \x0e?\xe3\xf7Q\x8c\xd6:[\x1a@\x08~\x89\x91\xef\x97\x0f\xce1\x1bM\x1c\xe1\x0d\xf1FgK\xf0\xa5g\xe2Tm\x85X\x19z\xb6\x05\xb4\x14\xc3KD\x04\x98\xb9\x13< \xb6Z\xf2YwO#\x80\xedx><\x06\xf8tg\xea\xb3#\xdeJE\xcb\xf4\x87\xfc\x8e\xdf\xe7;\xfe\x83s\xd2\x8fp\xffdk\xf0>\xf2[\x1d\xfdK\xf1?%\xdfq\xff\xb3\xf9\x0e=\xd9mc\x1a\xa1\xc8C\xe8\xd1K\x11\xb8\xda\xe5\xf7S\x13~\x93v\xb3B\x12\xaa\xfc\xc5=\xdb(\xa4\xa8\xd8\xbe g\x17R\xec\xe34\xab\xd2\xc2\x01`Y\\\xb7\xc2\xee=\x0e:\xd8%\xfdH\xfaw?\xd49{7fx\xa4\xdfg\xa1\x94\xf6\x9a\x15;\x97{Q6\xc8SE\xb8\xef\xfa\xb9\xea\xafZ\xb1+)\xdf\x80pp<\xd7\xfa\xa0e\xf1o\xdf`\xc8H'\xed\x0bS\x16\xb9@\xce\x8f\xdaw\x0e\xfb\x82\x8bf\xd4\xdd\xe5\x1a\x9d\xf1z\xe1\xf4!\xa6Uc\x80\x16b\xdf\xd9\xa6\x03\x9e\x02\xcf\xf8+0\x0d\x14\xfcv\xe0h\x1c\xb6w\xbfu\xddz\xf1\xf8\\\xb8\xb6\xdc\xa5\xb3\x04>\xb7Z\xa9RR2\xf7j\xdf\xb3\xe9\x9b\xa2p\xdb\xa9\xcca\x9e\x07`\n\xef\x1a\x9c=,WF\xbf\xcbO\xc6\xcd\xdaA\xd1\xa1\xc8\xb2+\xc6\xb9D\x1e/\xf5\x0b\xaa\xb6)@\xb9\xa9uXQ\xf4\x8f\x91\xdd\x89\x07/\x9a\x93vT\x1fX\x07?\x8aL$\xb9\x9fE*\xc0\x9c\xf8\x92\xe2\xfe\xa9\xa2}R\xfc?1\xdf!x_Jx\x1b\xcb\x10\xdf\xd0\xe5\x95\x8a\xa9L\x039=e+\xd7\x7fZ\xbec\xd1.\xd3\xce\x0bx\x17\xb4\x0c3\xf7\xcd\x8c_+\xa4[\xa7w\x1f\xdb.\xf5\xa8\xb3S;-\x91\xaf\xb6`\xf8\xe5\xa5\xdc;K\xe9\xb0\xcb;3p\x86\xc9zO\xc2J\xcd\x1f;\xd0\x8a\xc0L\xa7F\x08\xfb\xcd\xe0\x1fi\x81A\xd1b*H[\x82o\x11s\x05\xf590\xb0U\xc0\x00O\x84\x05U\xc5\xa2;\x12 y`K\xe9\\\x92\xa9\xb7d\x15\x08\xa7ET\xd0\x18\xab\xb1\x93\x12\xcej6y\x92\x92\x96M\xcb)\"5\"p\xce\xf3\x1c\xdf\x06y\xed\xf3\xfa\xf9T\x0e\xf1\xbc\x01\xbbb\xcb21T\x89\xd4Z\x1ds \xfeR\x89\x0f\x93\x92\xe2\x03\xa6\xf9\xa7QI\xf8M\xc9\xcb\xa7a)W\xdc;\xc4\xe1\xda.=009[i\xf4M\xfd\x03\xdc\xda\xa0\x7f\xc1\xa0\x1e\x1f\xf7\xa4f\\\xce\xae\x1b	\x83\x0fC>\xf6\xe6\xc9\xb2+U\xe0\xb7\xb7'\xd4\x92\xbf\x93\xa8\xfb\xc2\x01%\xef\xcc\xe3c\xd1\x18\xbd\xb7F#\x80F\xce\xf8\xa4o\xc2\xb7a\xb5z\xc6\x1fJF\x84u\xbd\xa2\xe6k\xa0\x7f\x80\xd3\xecOZ\xfa\xfe\x1f\xf2\x1d\xffa\x9c\x8eo\xbd\xb3o\xbd+~\xcdi\xfb\x97\xa2\xaeH\xd1\xffJN\xcf:\x17\xff\x95\xd3\xd5o9}\xe6c\x80\xa6a\x80\xbd\xa3\xe0y\x87\xff\\\x16\x0f\xf8\x01\xeb\x9fY}\xc2\xc2\xab\x7f\xf9N:\xb9p\x0e\xb5\x88K\x9d\x8fX\x11`\xc4\xd12\x9a\x8d \xbe\x08F!\x0c\x92El\xf4'\xe0\xee\xec\xf3\xa8\xe9	\x90OR\x07\xef\xc5\x14'\x97\x1eUp\xe3eE\xf7\xcb\xcb\xea:8\xc2\xc1\x0fu&8\xd8X\xd5M9\xe3\xa7\xcev\x14S\xec\xf3\x03Z\n{m\x1a\x13t\x1d\xd0\xa66\x96\xb9#\x11\xda\x14\x9dND9T\xf6*\xf0E\x82`y\xef\xe7oV\xe5\x99W\xe6\xf3{\xa3\xbcF\xaa\x9e\xf6R\xda/XGx8p	\x8b\xdfh \xafh\xd2\x1e\xd86\xa5\xdf\x17\x16\xe4\xc6\x8c\xe59l=\x16\x06\x0bx\x875P|\x0e\x19\xdcE~\xcd\x92s0x\x97F\xc1l\xc7c}\x92\x82\xe85\x94)\xf0\xd5\xc6\x117V)h\xac\x98b\xc3\x02\x8f\x8c\"s\xe3\xcd\xe2\xcb\x84\x96/\xd9\x08\xa3\xe4\x9a\xe5\xdc\xa6\xbe\xae\xffr\xb48\xb0\xf6\x99\xd5\xa0GY\xb024[\xa0~\x92a$\xbe\xb1\xf5\x1d\xfa\xb8A\x97\xad\xd9w\xcb\n\xee&Y\x07!y\xfd?Y\x0d\xfc\x80\xf5?\xc4j\x85\xc64Pt\x9am/\xc9\x13\xd3[\x1eT4\xcc\xcb\x81\x1ab\xb7?\xc2j\x80\xfd\xcb?[\x8c\xcdl\xb8\xb3q/]\x0d\xcc\xefgpZb\xab5\x8e~\xab8\xfbu?\x9f\xcc!\x82\x17W(\xc29X\xeb'0\xdd\xcd'v\xca\xba\x81\xee\xc5\xc9\xb3\xe5\xc7\xd0\x9d\"\xe93\xb0\xaeI\xa2\xecZ$W\xbb\x88\x07\xe3\xc0\xa4Q\\\x86\x84J7\\I\x03\x85\x18\xe8Q\\s\x7f\nV\x08*B\xf2B\x00\x89T\xcb\xe8%\x1bb7M\xe9\xabl\xda\xf1A\x02\x95\xe7Cj\xcd\xe6OSc\x05\xc7\xd4B\xd8\xd7_\xc2\xe2\x1d\x1azf\xff \xb3l\x94\xad\x80\xa2\x9e\xec\x02\xa4\x95r\xef\xec!\xcc6\xad9\xca\xe8h\x03	\xeb\xce\x88|a\x1c\xaa\x18\xe5\xe5hRG%\xa8}I\x08>l}\x10\xbb\x94+>\x94\xd9\x9c\xf3L2b\x00\xea\xc6\xca\xb0\x9b\x0eS\xc9e\xee\xa3\xb3\x8d]L\xcf\x1b|\x13\x9a)8m\x162\xc9\xd7%\xcbl\x9cG\x8f=S\x18\xeb\xeb\x19\xf2`\xbe\x12\xf2\xbc\x9e3o\x03>\x01\xe9\xd1\xd0+\xa8i\x0fy}\x85r\xf5\x9d7\xf86\xa5\x8d\x02\x07E\x1b\x8d\x7f\x9d\xd5\xbf\xcb\xe2\xf9\xff\xb99\x0f\x93g\x08\xbd\xab/\xe3\xd1a\xc3\xfb\x9d7\xfds\xf1\xdf3\x8b7\x14\xee_4\xf5\xbf\x92\xc5;\xbfr\xb5\xcc\xed%\x1b7\xd2}\xf3\x15\n\x8d&\xe2\xe5a\x13\x83L0#j\\`$\xb6K\x0d>\xc3uX\xc4o\xb7\xa1\xb2\xa8\"\x9b3M\xe3\xdd\x14\x1e\xb0}\xf1\xc0>\xa2\xc9>\xd1\x00mE\xc6D\xe5o\xc9\xb7\xaf%W\xc1R\x89b\xf0\x1cf7`\xef\xbd\x8e\xdf\x9e\x9e\x1b\xcbD\xbc\xc7U)o\xc9\x04\xdf\x1fr\x014\xffC\xbd\x14\xfc\x10\xe0qJ\xb3\xebV\xde\x0cMr\xf1'\xde\xebsys\xfb\"Dn\xe1\xaa\xd6i\xba\x1a=E4\x08\x8e\xf3p\xbds\x95\xf8\xe2Uk\xb9@\x87\x93\x96\xe0m\x998\x8bE\x14\x9d1W\xb4\xaa\xb6<&\xfe\xf8L\xaf=\xcf\xf7\xc9D*\x8fj\xda\xc1\xa7Y=\xee\xbd\x13\xb4l8\xae\xa3\xe6\xe5b:\x07\xc0\xcdw)\xc1\xc6\xb9;P\x056\x17+S5_\xc1Ny\xf3\xf5=&\xbe\x08\x11Q\xb8n\n<1d{\x1a\x12 D\xaa\x8a\xe0\xd4\xf5\xb7\x843\x143Z\x00\x9f\x86\xd8\xdda6\xc3\x1e,9\xf3\x17\xb6\x9f\x9c\xffgf\xf1\xf0m7o\xc4dD\xe7\xfe&\x9c\xab\x19\x15\xd7Z\x0e\x0f~\xac\xf9\xcf\xca\xe2\xc9\x8b\xa3\x0cZ\x83Tk\xa6\xcb\x03\x0c\x06\x93\n\x11hd\xdf\x88H\xd4:\xdaL'6\xc4\xbd\xf9\xde\xf8\xfb\xe9\xe8\x10\x0fH\x1e\xf9\xa2\x02_\xc7!\x98\x042\xf0\xe3\x06\x99_#\xdc\xb9$\xc7O\x08\x1e\xa2\xf2j\x0c\xf7O\x9f\x88\xa8ae\x83\xc1\xaf\xd7s}\xd5Z[\x1f\xcd\xc5\xeb\xc6x\xd2\xca\xa0\xd2\xeb\x8c\x95\xd31H\x80\xfe|\x1a'\xbci=\x15\xe5\xd4\xb5\xb4\xbdn\x0f\xef:\xe9\xca,\xfb\xf5V\x0b\x134\xe3\x85\x0d\x84\x85\xd2\x04\xe1\x16$\x1a\n\x89+\xc9}\x12\x7f\xa4\x8c\xf4=\xe0\x05\xd58H\xec\xa0<x\xf0\x14\xb3\xfaw\x7f\xc9!u\xa2Q&\xb2\xe5^i\x9a\x86\x87\xe9li\xc3l\xea\x06\xe8\xbcMH\xadUr	\xc2\xef\xa5\xbf\xa6\x86\x03\xac,\xd8?8f*\xc8f3Q\xdc\xc3\xe9D\xa5>_G\x1e\xfd\xfaJ\xe7\xfc\x0d\x0dY!\x07\x91\xd3%G\x02\xc3\xa8I\x8f\xf7\xae9\x9b\xf2\xc1\x1b\x07y\x07@%\xb8\x94M\xeb\xa1\xf5X\xb1l\xcf\x9a\xf0\x16i\xb5\xe9\xff\x88\xef\xc1\xfe\x94\xf3\x00\xff\xcahN`\x98\xbfw\x11\xcf:\xb1/\xeea\xd4\x9f\x81\x18{\xcc\x10x\x80\xee0?k\x03\xdb\x96y\xa6\xf0\x92P\x84\x0b\xe9\x1atG?\x85\x9f!\xa7\xf0\xcc\x12\xc5\x911\xa7!1\x15\x12}\xe8\xaer*\xccOk\x1a\xc0\xb3\x03\x9b\x85\"\x99\xa1x\x1d\xa0A\xab\x88\xc6\x90O\xc9\x12\xa3\xc1\xf3\xe6X5\x1b\xf5\xe3W\xbf\xec\xcd3\xcb/\xc7\xfb\xbd\xe3\x01\xba\xeb\x9f\xca\xf9\xd3\"\xcf\xb3\xd7\xdf,*\x92x\xfe\xa2-\xdd_}\xcf\x14\x8bG\xbc'\x10:\xaf\\\x80\xf10\xb7\xc1\xe7\xa7.\xce\x11\xef)\xf7O\x83/\xde\x0f\xe1\xcd\xfc\xa8\x9du\xfc\xfa]\xdf\xbfn\x0f\xf8]\x7f\xff\xdd{\xf2\xab\xbe\x7f\xdd6\xf0\xaf\xf4\xad\xbb\xcc)\xff\xfc\"<\xc0\xb3\xc5\xff\xd4\xf7\xffSS\x03\x7f5@~\xd2\xd4f\xae2\xfe\\\xdd\xea\xc1\x1e\xd6\x87P\\\xb9\x85\xe4\x94(\x8bW\x83\x95R\xf1L\xb8\xce.\xe4\xe7\xde-\xb3\xa6\x8d@r\xec\x0d0i\xac2$N\xd16\xdf#k\xeb\xe8A\xa4B\xa6\\\xf7\x82W\x0c\x8bE\x85\xa7\xab\x14\x8e%\xa85#\x96\x98\x16\x16 J+M\xc1\n\xfb\xe8\xd5\x9a\xe3\x04\xb86nS\xef\xb9\xb49|\xe0\xc8g\x9999Po\xbeK\xf0\x85\xe9'\xdb\xae\xb6(\xa3(o\xae\x8b\x1d\xda5\"m\xca/\xcc\x836Vs'3\xb3\xb3	\xf0\xb2\xa6\x92\xfb\x0b\x85W\xab%1\xa6\x942\xf5\xb3UF\xf7\xd6\xdah\x10-\xde\xefpVj?x\xe1?\x9dP\xca\x14\xd4\\\x185\xa5H$A\x98\x90_\x16\x80\xd1t\xee\x11=\xb5\xc5\x10\xe7\xd0}\xbe\xeb\x83\x7f\x0b\x82o\xd8\xe6\xd8\xb4\xd0p#\xefzo\x9b\x11\xb3\xab\xeeMjt*\xbdE\xe3\x8c0\x82\xed\x1b:}\xa3\x80\x08\x13\xaf\x03\x9c^\xbc\x05\x15V\xa9\xf9L\xaej\xad\x9d\xf8\xe3s\x94\x131\x11\xd4\xf5?kj\xe0\xaf\x06\xc8?\xa4\xa9\xc5a\xadE\\\xb4\x87\x8c\xbe-2\x07mL\x00Ll\x8f\xbe\xe0c\xfeNSk\xb4Gn1d\xb1I\x90Mrn\xe4vT\x11/\x0b7\x0f\xeb\xd7\x9a\x1a\xf8!\xaa\xb9\xf3\xaf\x9a\x9a/\xa3\xd6\xc5\xbe\xc8\x83w!3#\xfe7\xb85\xbe\x94\xbb\xfd\xb3\x0b2\xe2\xb4E\x8a\x94f\x94\x1e\xe2!\xd8)\xb1\x1ff\x0f\x08\x04fnx\x93&\\\x88\x8f\xe6\xa0um\x1dn\xf8\xdb\x00\x8f\xb7\xc2\x94H*F\xd2L\xa3\x93\xa4\xac\xb7\xaa\xb8\xb2\x8a\xbc\xc1l^.H\x1d\x95L\xd6\xb9\x04\x80\x07\xcfP+\x07AovL\x89\xc0\xbe\xa9\xefS\x8a\xfd\x01\xb9\xed\xa6\x9d\x11\xfc\x9e\xbf\xc8,\xf3\xb8\x1e4\xea,g\xbe\x1a\xf7#\xd3]\xf3'Wd\xd3\xf96\x80\x82\x05\x1d\x11}j>\xdc\xdf\xbc\xf5~AJ\x01}\xe6c\x80\xde`\x18\x11\xed\x07\xb4$7\xd7\xd8\xcaV\x14\x03\x1d\xc2\"\x99\xd7b\xbb^q\xb86#\xd4\xf6o\x80#W\xa3-\xdd3\xff\x86o\x8a\x83\xb1O\x0b\xf3e\xe5\xd9zL\xe0\x1f\xe2\xb40\xcc\xf6\x89F\xa5\x10uN\x13\x05\x8b\xbd\xd2\x83\x8d\n4\x9c7\xde\xe8 x\x026\xfc-K\xe3a\xed\xa8F*H\xbd\xaa\x92\xc1h\x85W\"\xa4o\xb9\x8aBvi\xf0\xfe\x00\xaf\x85\x1f{\xf5\xdb\xfe\x95\xd7*\xa3\x8a\x98|	\xab\xa6\xe3\xc3o\xffA\xfaq-\x7f\xed\xe3\x02\xbf\x12\x9d\xff\x9d\xae\xfco\xc5h\xd6	-\xf0\x8f\x88\xce\xffIs\x02\xff\x88\x91\xfb\x17\x1f\xf7\x97\xcf$\xfa\xb5\xd6\x06~\x12\xdb\x1es\xeb^~\xea\x9d\x8d\xfe5\xb0g\xf0?j\xf1/\xb5\xff\xc6\xbf\xb6\x81\x7f\xa5\xef_\xb7\x0d\xfc+}\xff\xbam\xe0\x9f\xef\xfbo\xf9\x0d\xfcw\x06\xf6\xcf\xfc\xe6\x82\x0f\x12w\x84\x12\xe2Q\x98\xd2\xb6\xf9\x96T\xde}\xf0eUt\x92e\xb2\xd5^\x80:\xfe>\x8a\x89\xbf\xbf\xaf\xfcU\x08\xd3q\x1c\\\xfc\xd2\xa1\xc7\xfc\xa8Hk\xabX\xce\xb7\x9a\x94b\xa8\x1c\xb0A.\x83\x93_D\xcf\x89\xab+\xd1\x9dp\xb3e$\x00\x85!\xea\xf0h\xea\x97x\xc7\xf5\x0eO\x9c\xc63\x96\xb2\x8e\xd7^\xbd\xeb\xc9\x14\x19\x81\xbc\x08\xefZs\xf03h\\Y\xa8B\xd1k\xf5-i1\xe2~\x8d\x1d`\xbd\xe9\x97\xfe@#4\xe9\x13\x0b\x11\xb4\xd0\xe9\xdf\\\xd5?\x9b?t\xb2I\xb24\xab\x0b\xf9\xd7\xe8\xcf\xef\xa7\xa4\xaf'\x85Y;\xd4\xee6p\xf2\xcc\\\xe6\x0e\x01N\x9c\xb5\xf68N\xc0\"Dm\xed\xa4\x991\x8eNf\xe4\xde%\xe3m\xd7GVTH\xc9\x1e\x97\x9a\xb3\xeb\x8b\xda\x06*4\xf8\xa2bu\xe3p\xe2\x17\x89t\x1a\x88\x82~D\xb0\xb9\xe5\xba\x8e\xea\xb6\x8b9Ej\x06\x83\xba\xb6\x06H\x92@H:{\xd0{\x1f\xa9'\x1a\xcc\xee\x98\x8bu\xbd\x7f\xe1w\xa7K\xc2	\xfc]\x03\x9by\xfd\x9a\xdf\xa2\xce\xfc\x94\xdd\xfb[v\xff\x05\xc7\xc0\xdf\xe5\xf1?\xc1n\xe0\xef\xed\xf7\xbf|\x8f\x0b\x1b\x83\x1a\xe3\xa5\x1e5\x15\xb1\x87\xfd\xff\x99;o-H\xcd\x05\x0d\xe6\xbc\nA\xe3\x1a\x13B\xe3\xbdw\x19\xde6\xde4<\xfd\x9e\xb9Z\xb9\x19\xcdhvu\x03\xa5\x7fNQ\xa7\x0e\xfc\x9frA~\x904\xa7^^=\xbc\xa3	\xfbY@M1\x01\xbf`\xeb\xcd\xa8\xfb1}\xee\x10\x07\x16\xb9\x11z\x07\x17\xe6\x18\x0d\x0e\x9b\x03z\x90\xf8>\x8fM\x0b7+\xb5\x10NNW\xdd\x9e\xe3\xc1!\x15\x81\x8a\x18$\xca\x00\x8b\xa2\x92}\xaa\x03\xf4\xd4\xdaS\xb4\xb7\xb2W\x8dg\x0dcIB9\xeb4\xcbr\x95i\xb0M\xc4\xe8DG\xc5\x8c\xa7\x13\xc3~bGX\xa2-\x90pl\xc6\x80\x1akpb\x0eh\x81\xe5\xb3\xd0q\x03\xb8\x93!H\xd0z\xe6%	n\x115;\xa9F\xfb\x96\xaflpGJW\x14\xb7l\xb7\x1f\xca\xb7\xb1\x93\xa0\x89\x02\x16\x80\xe0\x1da\x89W=\xc8\xc4\xfc\xbc\x9a1Bn\xceR\xf4\xfe\xf9\x14\xea\ng\xcf\x0b\xc4\x99O\xe2\xe3v\xefS\x0b\xc5%\xce+i`x\x82\xd3\xcay\xe2d`\x0d\x00\x16\xe8\x96\x82\xe2\x9cP\xa0D\x8f8\xc6\xc42\xb8\xe3.\xf3\x93x,\xad\xcd\x1d\xef\x99\x84\xd0\xa8\xaf\x1eB\x89\xcf\xf3\xad\xfc\x03v\xbf\xbe<\xc8\x87\xf0\x13\xae\xed\xfd;];:e\xfaW\xd7\x86\xbfrN\xb8WC\xfd\xc8C{\xfc\x9a\xd9\xff\x8bl\x18\xd0\xfe\xc3m\x0f\xd3Y\xfd\x17f\xbb4\xa2\xb1\xdcGk\xe9S\xfb\xe5\x8e\xd3\x1f\xf6\x11\xe0/\xd8\xfc\x7f\xea#\xc0\xff\x93\xd9\xbf\xf5\x11\xe0\xeb@\xe2]\xcc\x16;?\xff\xaf\"\xf0\xcd\x90mnDf,\x82M-\xd3#^\xeb\x91\xc6<\x9b\xdb\xf4\x99\x02u\xa3z\x88\xdf$\x18\xa2\xd2\x98$Q\xa3l\xeb\x04\x03\xb2\\w\xae\x0b\xbd\xb8\xa2\x8d$\xebI\xf25f-\x9aw\x05\x87G\xcek\xae\xa0y\xa3_\x96=Fj\x9am+cV\x1f\xc6\x86w\x88(<\x99=P\xf2\x01\xbc`\xb9\x9f+\xf1\xad\x0dZ\x83]8)]\xc4\xf5>\x9e\x90U\xd1\xda\x9e0s\xc51\xb6\x04\xdb\xb8Z\xa6\xd7\x92\"\xd1!>\xb7O+_\xae\xaf\x9d\xfb\xab\xcc\x81\xc8\xa4\xb7\xd8\x1fw\xfb\x86\xe4\xcf{(W\xf1\xfa\xb4\x1c\xe8\x8cO|7\x14\x97\xddO\x10\x14GL\xacc\xc2:\x8a\xa2%B\x86LAS2\xf7e\xec\x86\x1c\x05x\x8by\xda~\x1a\xda\xc7\xf6\x8c3\x9f\xc5x\xa4L\xb9\x9c\x19\x1a\x9e\xe0i\x1e\xcb\xb9f,\xfd\x97%X\xc8p\x0d.\x91\xc79'\x99\xa5\x99\x8b\xe8@\xc4o\x0e\xe8i\xe3}\x8a\x16.\x90\xa8\xb16\xa2\xcd\xa8C:\x81VlW\xc6\xdav\xa2\xfcq\x11(\xb5\xbe\xdfG\x80\xaf\x03\xc9\xdf\xf5\x11\x83\xf3Y\x8d\xf0\xc7\xb7-b\xa2\x9f\xd3\x80uH\x9a>#[hd\xdf\x8c\xd9>\xb1\xacd\xdb\xead>\xd8\x951\"	>\x162\x08\xf3\xa4\x99\xa3o\xde\xe1\xea\xefc\xb6\xf5\xa1\xadk\x0b\xba5)Mzaz\xb8-BL\xa3\xe2#\x95a(a\xa3\x16&qr\xc1\xe7\x91\n\x0e\xf1\x0d\x98\xc1c\xe1T\xd6<T\xdb\xd3\xb5R\xd1@\xe5\xcew5\x06{\x84\x12\x93\xfaS\x94E\x1b\x99\xe1v\x84L??\xb8\x07\x95\xdd<\xcbbe\x10V\xe0\xed\x8f;\xb0\xb3a}->\xd6\xe9o\x13s\xa0b^i\xf3\xd1Z\xa2i\xc4\xb4\xc6\xd3\xd8\xe5\x9f\x06U\xf7\xf3\xbb0\xeb\x8b\x1d\xd6\xfbUS\x19l\x96\xb2\xc3\xd4\x18\x05\xa6\x00v\x12\xcdCE}\xc6\xd06\xb2E9%:\xe6\x1b\xaa\xfcr*\xe6Z>UL,.\xd2K\xfdR\xb6yY\x93\xefR\x17\xa7\xc2\x9c7\xd6\xa6#\xe2j}\xc0\x8c\xe6\xddN|$I\x1f\x1c\xf8 |\xbe\x0b4\x0d\xdb\x92;\xba\x1f\xf3\x1a\xd1H\xeb\x88 \xf6a\xc5#\xeaHuX\xd3l\xe1\xa9for\xeb\xcde\xcf5\x05\xf8*\x0b\x12G\x110Vn3\xe9\xd9\x11\xb4\xac\x06Yx\xb9\xec\x86\xc0\xaf\x06CU\x0e\x94(\xeb\x9f\xf4\x11\xae\xfd\xc2\xec\xf9w\xdf\xd6\x8b\x13\xc5\x8a\xfa~\xf6\xe4w|\xdb\xffw\xfa\xf6[{Y\xbfzg\xfeC\x86\xf1\xf2\x91\xa2\xd6\x7f\xbeq\x03\xc4:\xd35\xb7;u\x97;\xb5\x96\x83\x0cVB\x83/g\xadu\xea.\x8d\xfdv\xd6\xbe\x98\xde\xfd\xab\x8e\xff\x9dV\xff\xa3\xbe\xff\xdf\xed\xf8rKw\xbfv|\xd1\xfbs\xc7O\x03o\xcb\x10\n\xce\x85\xea\xcf\x8d\xe8\x0f\x0c\x07\xfe.rO\x04\x13\xf9$\xa9\xed]\x16\x81\xcb\x0c-\x04\x05\xbeH\xef\xee\\\x14*\xb9\xd2\x05\x123L?p\x8ek\xc4^\x17\x82\x8e\xf1Z2Fo\x15\x949X\x8f\xcdD\xb3%\xd3\x15k~S\x9d\xdc\xcc2i8\x8a\xc1f\xa5d\xcb\xc2\x10l+\xa3\x03<\x1a\xef\x07\xd1\xa2\xc8\xe9\xd4\xa1\xa9d\xbd\xeb~\xf8S\x8d\xb5\x8cM\xa9\x99\xdf1i\x01\xdd\xf9\xa8(\x13(\n\xa9f\xda\x1e\xa4#\x97\xbe\x8c\x15UiO\x83\x04\xaa\xce{\xa0\x06\x92\xad\xf2\xc3\xe0-}h\xb1\x0c\xd1\xad~\x144o\xe2\xdeE~\xca\x9aqYV\x99y\xd7F\xbd\x8e\x98-%S\x1f\xea\xc2s\x10\xaa\x15\x9c\x13\xe0\xecI.\x1e4\xa7\xef\xa4\xf9\xb92\x02i\x93\xd0\xdaY\x90\xe7\x1a\xe4\xd5\xe1=\xd39}\x10Y|\xed\x8e\xdbF\x17\xc2\xe1\xf3\xfa\xebV\xd6\xfb5l\xcb6\xe7\xc0\x92G\xde\xb9/\xd1\xaa\xba!+\x1e\xd4\x10 u\xc9zV\x02\xbd\xeb\xb0\xa4*\x9d5\x18\xc9\xa7\xe3\xbd\xb2)}\xd6m\xfd\x8aT\xd1\xfa}\x8b\x1c\xf8\xd91\xf2\xbf\xda\"\xff\x82d\xe0{L\xfeY~\x03\x7f\x04\xf87\xfc\x96$\xcdu\xcc\xcf#\xb7\x89\xb6\xcf>U\xb5\x80\x06n\\\x11F\xd9}2!}\xa2\xd0@\xf1z\x84\x12v9&zX\x9d\xc4\xf7&\x89 \x1e\xa5\xd4dB\xad\x18^\xd8\xd0\xde\x9c>F\x0bj\x92f;\xd4(Q\x19\xb6f'M\x8b\xff\x9a\x8b\xd0'\x80\xe1\xa2Fv[\xd2\xb2\xbfZB\xcf\x84<V\x8f\xfdv\xce\xaa\xa7\x9c\xc8\x95'\xc2\xf4_\xf1\x16\xef\xdbjF}*/\x8c)\x9e<\xcd\x1ct\xa7\x1d\xf6\xc62@\xddlR\x85\xd1\x9c\xc3\n\xe6\xf8\xea)l\x8d\xc4\xa9d\xcbv\xf2X\xcdYh\x8d\xf5.\x03g/&\x8f\xc5\xe7r\xd9\x1a\xbd\xa2\xbd\xb0\xad\xef\xae\xd3\xbde\x1d\x81\xc4\x0dp\x1bz\xe2$\x1cC\xef\xa3\xb3\xf3\x14\xcc\xf2pS\x0cggcs,\x90S\x96\xa5\xe4F\xa5^\xb7tga\x9e\xae\xf4\xd6\xa2\xea\xbd\xe1\x0d\xbd\xf2\xf5\x01\xa0G\xe9:\xae\xb2\xc4\xac\xee\xca\x03v\xa5\x02>\xcd\xf0\x1dp\x9f1\\\xbb-6\xaf^\xee/\xdcFm\xe6\xf4\xf1\xe1\x9c\x19\xedG\xfc\xfe\x9f\x00\x00\x00\xff\xffPK\x07\x08\xb7s\x15\xe0\x00:\x00\x00$\x82\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xd3]\x13O\xb7s\x15\xe0\x00:\x00\x00$\x82\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00rds-ca.pemUT\x05\x00\x01\x1f\x8cZ]PK\x05\x06\x00\x00\x00\x00\x01\x00\x01\x00A\x00\x00\x00A:\x00\x00\x00\x00"
-	fs.Register(data)
-}
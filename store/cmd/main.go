@@ -3,6 +3,8 @@ package main
 import (
 	"flag"
 	"fmt"
+	"log/slog"
+	"os"
 
 	"github.com/a-h/go-sql-driver-rds-credentials/store"
 )
@@ -13,7 +15,8 @@ func main() {
 	flag.Parse()
 
 	fmt.Println("Getting plain secret twice (to validate caching):")
-	s := store.New(*secretNameFlag)
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	s := store.New(*secretNameFlag, store.WithLogger(logger))
 	secret, err := s.Get(false)
 	if err != nil {
 		panic(err)
@@ -22,7 +25,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	fmt.Println(secret)
+	fmt.Println(store.Redacted(secret))
 	fmt.Println("Calls Made:", s.CallsMade())
 	fmt.Println()
 
@@ -31,7 +34,7 @@ func main() {
 		"parseTime":       "true",
 		"multiStatements": "true",
 		"collation":       "utf8mb4_unicode_ci",
-	})
+	}, store.WithLogger(logger))
 	if err != nil {
 		panic(err)
 	}
@@ -44,5 +47,5 @@ func main() {
 		panic(err)
 	}
 	fmt.Println("Calls Made:", rds.CallsMade())
-	fmt.Println(secret)
+	fmt.Println(store.Redacted(secret))
 }
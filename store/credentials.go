@@ -0,0 +1,56 @@
+package store
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+)
+
+// Credentials to connect to a database, read from a secret.
+type Credentials struct {
+	Username string
+	Password string
+	Host     string
+	Port     int
+	DBName   string
+	Engine   string
+	// VersionID of the secret that the credentials were read from.
+	VersionID string
+}
+
+// Addr returns the host and port, in host:port format.
+func (c Credentials) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// String returns the credentials with the password masked, so that they can't be written
+// to logs by accident.
+func (c Credentials) String() string {
+	if c.Password != "" {
+		c.Password = mask
+	}
+	return fmt.Sprintf("{Username:%s Password:%s Host:%s Port:%d DBName:%s Engine:%s VersionID:%s}",
+		c.Username, c.Password, c.Host, c.Port, c.DBName, c.Engine, c.VersionID)
+}
+
+// LogValue implements slog.LogValuer, masking the password.
+func (c Credentials) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("username", c.Username),
+		slog.String("host", c.Host),
+		slog.Int("port", c.Port),
+		slog.String("dbname", c.DBName),
+		slog.String("engine", c.Engine),
+		slog.String("version_id", c.VersionID),
+	}
+	if c.Password != "" {
+		attrs = append(attrs, slog.String("password", mask))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// GoString masks the password when the credentials are formatted with %#v.
+func (c Credentials) GoString() string {
+	return "store.Credentials" + c.String()
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+)
+
+// WithLogger logs refreshes, retries, version changes and failures. Successful refreshes
+// and version changes are logged at info level, retries and stale values served because
+// of an error at warn level, and failures at error level. Secret values are never logged.
+func WithLogger(l *slog.Logger) Option {
+	return func(o *options) {
+		o.observers = append(o.observers, logger{l: l})
+	}
+}
+
+// logger is an Observer which logs events.
+type logger struct {
+	l *slog.Logger
+}
+
+func (l logger) Observe(e Event) {
+	ctx := context.Background()
+	switch e := e.(type) {
+	case RefreshStarted:
+		l.l.LogAttrs(ctx, slog.LevelDebug, "store: refreshing secret",
+			slog.String("secret", e.Secret), slog.String("stage", e.Stage),
+			slog.Bool("forced", e.Forced), slog.Bool("background", e.Background))
+	case ForcedRefreshSkipped:
+		l.l.LogAttrs(ctx, slog.LevelDebug, "store: skipped forced refresh",
+			slog.String("secret", e.Secret), slog.String("stage", e.Stage), slog.Bool("rate_limited", e.RateLimited))
+	case RefreshSucceeded:
+		l.l.LogAttrs(ctx, slog.LevelInfo, "store: refreshed secret",
+			slog.String("secret", e.Secret), slog.String("stage", e.Stage), slog.String("version_id", e.VersionID),
+			slog.Bool("background", e.Background), slog.Duration("duration", e.Duration))
+	case RefreshFailed:
+		l.l.LogAttrs(ctx, slog.LevelError, "store: failed to refresh secret",
+			slog.String("secret", e.Secret), slog.String("stage", e.Stage),
+			slog.Bool("background", e.Background), slog.Duration("duration", e.Duration), slog.Any("err", e.Err))
+	case RetryAttempted:
+		l.l.LogAttrs(ctx, slog.LevelWarn, "store: retrying Secrets Manager",
+			slog.String("secret", e.Secret), slog.String("stage", e.Stage),
+			slog.Int("attempt", e.Attempt), slog.Duration("delay", e.Delay), slog.Any("err", e.Err))
+	case VersionChanged:
+		l.l.LogAttrs(ctx, slog.LevelInfo, "store: secret version changed",
+			slog.String("secret", e.Secret), slog.String("previous_version_id", e.PreviousVersionID), slog.String("version_id", e.VersionID))
+	case StaleValueServed:
+		if e.Err == nil {
+			// Serving the cached value while another caller refreshes it is expected.
+			l.l.LogAttrs(ctx, slog.LevelDebug, "store: serving stale secret during refresh", slog.String("secret", e.Secret))
+			return
+		}
+		l.l.LogAttrs(ctx, slog.LevelWarn, "store: serving stale secret", slog.String("secret", e.Secret), slog.Any("err", e.Err))
+	case ParseFailed:
+		l.l.LogAttrs(ctx, slog.LevelError, "store: failed to parse RDS secret", slog.String("secret", e.Secret), slog.Any("err", e.Err))
+	}
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+// newTestLogger returns a logger which writes to buf, without times or durations, so that
+// the output can be compared.
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == "duration" {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func TestLogger(t *testing.T) {
+	retrieveErr := errors.New("retrieve failed")
+	tests := []struct {
+		name     string
+		event    Event
+		expected string
+	}{
+		{
+			name:     "cache hits aren't logged",
+			event:    CacheHit{Secret: "a", Stage: sm.StageCurrent},
+			expected: "",
+		},
+		{
+			name:     "refreshes are logged at info level",
+			event:    RefreshSucceeded{Secret: "a", Stage: sm.StageCurrent, VersionID: "v1"},
+			expected: `level=INFO msg="store: refreshed secret" secret=a stage=AWSCURRENT version_id=v1 background=false`,
+		},
+		{
+			name:     "failed refreshes are logged at error level",
+			event:    RefreshFailed{Secret: "a", Stage: sm.StageCurrent, Err: retrieveErr},
+			expected: `level=ERROR msg="store: failed to refresh secret" secret=a stage=AWSCURRENT background=false err="retrieve failed"`,
+		},
+		{
+			name:     "retries are logged at warn level",
+			event:    RetryAttempted{Secret: "a", Stage: sm.StageCurrent, Attempt: 1, Err: retrieveErr},
+			expected: `level=WARN msg="store: retrying Secrets Manager" secret=a stage=AWSCURRENT attempt=1 delay=0s err="retrieve failed"`,
+		},
+		{
+			name:     "skipped forced refreshes are logged at debug level",
+			event:    ForcedRefreshSkipped{Secret: "a", Stage: sm.StageCurrent, RateLimited: true},
+			expected: `level=DEBUG msg="store: skipped forced refresh" secret=a stage=AWSCURRENT rate_limited=true`,
+		},
+		{
+			name:     "version changes are logged at info level",
+			event:    VersionChanged{Secret: "a", PreviousVersionID: "v1", VersionID: "v2"},
+			expected: `level=INFO msg="store: secret version changed" secret=a previous_version_id=v1 version_id=v2`,
+		},
+		{
+			name:     "stale values served during a refresh are logged at debug level",
+			event:    StaleValueServed{Secret: "a"},
+			expected: `level=DEBUG msg="store: serving stale secret during refresh" secret=a`,
+		},
+		{
+			name:     "stale values served after an error are logged at warn level",
+			event:    StaleValueServed{Secret: "a", Err: retrieveErr},
+			expected: `level=WARN msg="store: serving stale secret" secret=a err="retrieve failed"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			buf := new(bytes.Buffer)
+			logger{l: newTestLogger(buf)}.Observe(tt.event)
+			if actual := strings.TrimSpace(buf.String()); actual != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRDSWithLogger(t *testing.T) {
+	t.Parallel()
+	buf := new(bytes.Buffer)
+	s, err := NewRDS("secret_ARN", "db", nil, WithLogger(newTestLogger(buf)), WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		return sm.Value{SecretString: `{"username":"user","password":"secret_password","host":"host","port":3306}`, VersionID: "v1"}, nil
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer s.Close()
+	if _, err = s.Get(false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `msg="store: refreshed secret" secret=secret_ARN`) {
+		t.Errorf("expected the refresh to be logged, got:\n%s", buf.String())
+	}
+	if strings.Contains(buf.String(), "secret_password") {
+		t.Errorf("the password was logged:\n%s", buf.String())
+	}
+}
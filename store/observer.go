@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+// Observer receives events from a Secret or RDS store, e.g. to record metrics. Observers
+// are called synchronously, from any goroutine, so must be safe for concurrent use and
+// return quickly.
+type Observer interface {
+	Observe(e Event)
+}
+
+// ObserverFunc adapts a function to an Observer.
+type ObserverFunc func(e Event)
+
+// Observe calls f(e).
+func (f ObserverFunc) Observe(e Event) {
+	f(e)
+}
+
+// Event is one of CacheHit, CacheMiss, RefreshStarted, ForcedRefreshSkipped,
+// RefreshSucceeded, RefreshFailed, CallCompleted, RetryAttempted, VersionChanged,
+// StaleValueServed or ParseFailed.
+type Event interface {
+	event()
+}
+
+// CacheHit is observed when the cached value is returned without calling Secrets Manager,
+// and the refresh wasn't forced.
+type CacheHit struct {
+	Secret string
+	Stage  string
+}
+
+// CacheMiss is observed when the cached value is missing or expired.
+type CacheMiss struct {
+	Secret string
+	Stage  string
+}
+
+// RefreshStarted is observed before Secrets Manager is called.
+type RefreshStarted struct {
+	Secret string
+	Stage  string
+	// Forced is true if the caller forced the refresh.
+	Forced bool
+	// Background is true if the refresh was made by the background refresher.
+	Background bool
+}
+
+// ForcedRefreshSkipped is observed when a forced refresh returns the cached value without
+// calling Secrets Manager, because another caller refreshed the secret while it waited, or
+// the secret was refreshed within the minimum interval (see WithMinForceInterval).
+type ForcedRefreshSkipped struct {
+	Secret string
+	Stage  string
+	// RateLimited is true if the refresh was skipped because of the minimum interval, or
+	// false if it joined another caller's refresh.
+	RateLimited bool
+}
+
+// RefreshSucceeded is observed when the secret was retrieved, including any retries.
+type RefreshSucceeded struct {
+	Secret    string
+	Stage     string
+	VersionID string
+	// CreatedDate is when the version was created, if the retriever provides it.
+	CreatedDate time.Time
+	Background  bool
+	Duration    time.Duration
+}
+
+// RefreshFailed is observed when the secret couldn't be retrieved, after any retries.
+type RefreshFailed struct {
+	Secret     string
+	Stage      string
+	Background bool
+	Duration   time.Duration
+	Err        error
+}
+
+// CallCompleted is observed after each call to Secrets Manager, including calls which are
+// retried, so a refresh may result in several.
+type CallCompleted struct {
+	Secret   string
+	Stage    string
+	Duration time.Duration
+	// Err is nil if the call succeeded.
+	Err error
+}
+
+// RetryAttempted is observed before a failed call to Secrets Manager is retried.
+type RetryAttempted struct {
+	Secret string
+	Stage  string
+	// Attempt is 1 for the first retry.
+	Attempt int
+	Delay   time.Duration
+	Err     error
+}
+
+// VersionChanged is observed when a refresh returns a different version of the current
+// secret, e.g. after a rotation.
+type VersionChanged struct {
+	Secret            string
+	PreviousVersionID string
+	VersionID         string
+}
+
+// StaleValueServed is observed when an expired value is returned, because the secret
+// couldn't be retrieved, or because another caller is retrieving it.
+type StaleValueServed struct {
+	Secret string
+	// Err is the error retrieving the secret, or nil if a refresh is in progress.
+	Err error
+}
+
+// ParseFailed is observed when the RDS store can't parse or format the secret.
+type ParseFailed struct {
+	Secret string
+	Err    error
+}
+
+func (CacheHit) event()             {}
+func (CacheMiss) event()            {}
+func (RefreshStarted) event()       {}
+func (ForcedRefreshSkipped) event() {}
+func (RefreshSucceeded) event()     {}
+func (RefreshFailed) event()        {}
+func (CallCompleted) event()        {}
+func (RetryAttempted) event()       {}
+func (VersionChanged) event()       {}
+func (StaleValueServed) event()     {}
+func (ParseFailed) event()          {}
+
+// observers are the observers configured with WithObserver.
+type observers []Observer
+
+func (o observers) observe(e Event) {
+	for _, observer := range o {
+		observer.Observe(e)
+	}
+}
+
+// onCall observes each call made by f.
+func (o observers) onCall(f sm.RetrieveFunc) sm.RetrieveFunc {
+	if len(o) == 0 {
+		return f
+	}
+	return func(ctx context.Context, name, stage string) (v sm.Value, err error) {
+		start := time.Now()
+		v, err = f(ctx, name, stage)
+		o.observe(CallCompleted{Secret: name, Stage: stage, Duration: time.Since(start), Err: err})
+		return
+	}
+}
+
+// onRetry adds a RetryAttempted event to the retry policy, keeping any existing OnRetry.
+func (o observers) onRetry(p sm.RetryPolicy) sm.RetryPolicy {
+	if len(o) == 0 {
+		return p
+	}
+	next := p.OnRetry
+	p.OnRetry = func(name, stage string, attempt int, delay time.Duration, err error) {
+		o.observe(RetryAttempted{Secret: name, Stage: stage, Attempt: attempt, Delay: delay, Err: err})
+		if next != nil {
+			next(name, stage, attempt, delay, err)
+		}
+	}
+	return p
+}
@@ -0,0 +1,200 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestSecretObserver(t *testing.T) {
+	retrievalError := errors.New("retrieval error")
+	results := []struct {
+		v   sm.Value
+		err error
+	}{
+		{v: sm.Value{SecretString: "first", VersionID: "v1"}},
+		{v: sm.Value{SecretString: "second", VersionID: "v2"}},
+		{err: retrievalError},
+	}
+	var calls int
+	o := &recordingObserver{}
+	s := New("secret_ARN", WithObserver(o), WithRetryPolicy(sm.NoRetry), WithMinForceInterval(0))
+	s.retrieve = func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		r := results[calls]
+		calls++
+		return r.v, r.err
+	}
+	s.Get(false)
+	s.Get(false)
+	s.Refresh(context.Background())
+	// Expire the cache, so that the failure results in the stale value being served.
+	s.current.Store(&Snapshot{Value: "second", VersionID: "v2", ExpiresAt: time.Now().UTC().Add(-time.Minute)})
+	s.Get(false)
+
+	expected := []Event{
+		CacheMiss{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshSucceeded{Secret: "secret_ARN", Stage: sm.StageCurrent, VersionID: "v1"},
+		CacheHit{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent, Forced: true},
+		RefreshSucceeded{Secret: "secret_ARN", Stage: sm.StageCurrent, VersionID: "v2"},
+		VersionChanged{Secret: "secret_ARN", PreviousVersionID: "v1", VersionID: "v2"},
+		CacheMiss{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshFailed{Secret: "secret_ARN", Stage: sm.StageCurrent, Err: retrievalError},
+		StaleValueServed{Secret: "secret_ARN", Err: retrievalError},
+	}
+	if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected events:\n%#v\ngot:\n%#v", expected, actual)
+	}
+}
+
+func TestSecretObserverSkippedForcedRefreshes(t *testing.T) {
+	t.Run("rate limited", func(t *testing.T) {
+		o := &recordingObserver{}
+		s := New("secret_ARN", WithObserver(o), WithMinForceInterval(time.Minute))
+		s.retrieve = func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+			return sm.Value{SecretString: "first", VersionID: "v1"}, nil
+		}
+		s.Get(false)
+		s.Refresh(context.Background())
+		expected := []Event{
+			CacheMiss{Secret: "secret_ARN", Stage: sm.StageCurrent},
+			RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent},
+			RefreshSucceeded{Secret: "secret_ARN", Stage: sm.StageCurrent, VersionID: "v1"},
+			ForcedRefreshSkipped{Secret: "secret_ARN", Stage: sm.StageCurrent, RateLimited: true},
+		}
+		if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected events:\n%#v\ngot:\n%#v", expected, actual)
+		}
+	})
+	t.Run("joined", func(t *testing.T) {
+		o := &recordingObserver{}
+		s := New("secret_ARN", WithObserver(o), WithMinForceInterval(0))
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var once sync.Once
+		s.retrieve = func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+			once.Do(func() {
+				close(started)
+				<-release
+			})
+			return sm.Value{SecretString: "first", VersionID: "v1"}, nil
+		}
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s.Refresh(context.Background())
+		}()
+		<-started
+		go func() {
+			defer wg.Done()
+			s.Refresh(context.Background())
+		}()
+		// Wait for the second refresh to wait for the first.
+		time.Sleep(time.Millisecond * 50)
+		close(release)
+		wg.Wait()
+		expected := []Event{
+			RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent, Forced: true},
+			RefreshSucceeded{Secret: "secret_ARN", Stage: sm.StageCurrent, VersionID: "v1"},
+			ForcedRefreshSkipped{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		}
+		if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected events:\n%#v\ngot:\n%#v", expected, actual)
+		}
+	})
+}
+
+func TestSecretObserverRetries(t *testing.T) {
+	throttled := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	o := &recordingObserver{}
+	var calls int
+	s := New("secret_ARN", WithObserver(o), WithRetryPolicy(sm.RetryPolicy{MaxAttempts: 2}), WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		calls++
+		if calls == 1 {
+			return v, throttled
+		}
+		return sm.Value{SecretString: "secret", VersionID: "v1"}, nil
+	}))
+	if _, err := s.Get(false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []Event{
+		CacheMiss{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshStarted{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		CallCompleted{Secret: "secret_ARN", Stage: sm.StageCurrent, Err: throttled},
+		RetryAttempted{Secret: "secret_ARN", Stage: sm.StageCurrent, Attempt: 1, Err: throttled},
+		CallCompleted{Secret: "secret_ARN", Stage: sm.StageCurrent},
+		RefreshSucceeded{Secret: "secret_ARN", Stage: sm.StageCurrent, VersionID: "v1"},
+	}
+	if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected events:\n%#v\ngot:\n%#v", expected, actual)
+	}
+}
+
+func TestRDSObserver(t *testing.T) {
+	o := &recordingObserver{}
+	s, err := NewRDS("secret_ARN", "databaseName", nil, WithObserver(o))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer s.Close()
+	s.child = &mockSecret{
+		GetResults: []SecretGetResult{
+			{
+				Credential: `{ "username": "user" }`,
+			},
+		},
+	}
+	_, err = s.Get(false)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	expected := []Event{
+		ParseFailed{Secret: "secret_ARN", Err: err},
+	}
+	if actual := o.get(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected events %#v, got %#v", expected, actual)
+	}
+}
+
+// recordingObserver records events, with durations and delays removed, so that they can be
+// compared.
+type recordingObserver struct {
+	m      sync.Mutex
+	events []Event
+}
+
+func (o *recordingObserver) Observe(e Event) {
+	switch ev := e.(type) {
+	case RefreshSucceeded:
+		ev.Duration = 0
+		e = ev
+	case RefreshFailed:
+		ev.Duration = 0
+		e = ev
+	case CallCompleted:
+		ev.Duration = 0
+		e = ev
+	case RetryAttempted:
+		ev.Delay = 0
+		e = ev
+	}
+	o.m.Lock()
+	defer o.m.Unlock()
+	o.events = append(o.events, e)
+}
+
+func (o *recordingObserver) get() []Event {
+	o.m.Lock()
+	defer o.m.Unlock()
+	return append([]Event{}, o.events...)
+}
@@ -0,0 +1,210 @@
+package store
+
+import (
+	"text/template"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/certs"
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Option configures a Secret or RDS store.
+type Option func(o *options)
+
+type options struct {
+	cacheFor         time.Duration
+	refreshBefore    time.Duration
+	refreshJitter    time.Duration
+	maxStaleness     time.Duration
+	errorBackoff     time.Duration
+	minForceInterval time.Duration
+	retryPolicy      sm.RetryPolicy
+	retrieve         sm.RetrieveFunc
+	hostKey          string
+	mapping          FieldMapping
+	dsnTemplate      *template.Template
+	tls              tlsOptions
+	observers        observers
+	tracer           trace.Tracer
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		cacheFor:         defaultCacheDuration,
+		refreshBefore:    defaultRefreshBefore,
+		refreshJitter:    defaultRefreshJitter,
+		maxStaleness:     defaultMaxStaleness,
+		errorBackoff:     defaultErrorBackoff,
+		minForceInterval: defaultMinForceInterval,
+		retryPolicy:      sm.DefaultRetryPolicy,
+		retrieve:         sm.DefaultRetrieveValue,
+		hostKey:          defaultHostKey,
+		tracer:           defaultTracer(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithCacheFor sets how long the secret is cached before it's retrieved again. Defaults to
+// 24 hours.
+func WithCacheFor(d time.Duration) Option {
+	return func(o *options) {
+		o.cacheFor = d
+	}
+}
+
+// WithRefreshBefore sets how long before the cache expires that the background refresher
+// retrieves the secret. See Secret.Start. The secret isn't refreshed before half of the
+// cache duration has passed, or more than once a second. Defaults to 5 minutes.
+func WithRefreshBefore(d time.Duration) Option {
+	return func(o *options) {
+		o.refreshBefore = d
+	}
+}
+
+// WithRefreshJitter sets the maximum random duration added to the refresh before duration,
+// so that a fleet of processes doesn't refresh at the same moment. Defaults to 1 minute.
+func WithRefreshJitter(d time.Duration) Option {
+	return func(o *options) {
+		o.refreshJitter = d
+	}
+}
+
+// WithMaxStaleness sets how long after the cache expires that the cached value continues to
+// be returned if the secret can't be retrieved. Forced refreshes always return errors.
+// Defaults to 1 hour.
+func WithMaxStaleness(d time.Duration) Option {
+	return func(o *options) {
+		o.maxStaleness = d
+	}
+}
+
+// WithErrorBackoff sets how long to wait after failing to retrieve the secret before trying
+// again. In the meantime, the stale value (or the error, if there isn't a usable stale
+// value) is returned. Defaults to 5 seconds.
+func WithErrorBackoff(d time.Duration) Option {
+	return func(o *options) {
+		o.errorBackoff = d
+	}
+}
+
+// WithMinForceInterval sets the minimum time between forced refreshes. Forced refreshes
+// within this interval of the last refresh return the cached value, so that connections
+// which are rejected after a refresh has completed don't each call Secrets Manager and
+// exceed its rate limit. Defaults to 5 seconds. Set to 0 to always retrieve the secret.
+func WithMinForceInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.minForceInterval = d
+	}
+}
+
+// WithObserver adds an observer, which receives events such as cache hits, refreshes and
+// version changes. Can be used more than once to add several observers.
+func WithObserver(observer Observer) Option {
+	return func(o *options) {
+		o.observers = append(o.observers, observer)
+	}
+}
+
+// WithRetryPolicy sets how failed calls to Secrets Manager are retried. Defaults to
+// sm.DefaultRetryPolicy. Use sm.NoRetry to disable retries.
+func WithRetryPolicy(p sm.RetryPolicy) Option {
+	return func(o *options) {
+		o.retryPolicy = p
+	}
+}
+
+// WithRetriever sets the Retriever used to get secrets from Secrets Manager, e.g. to reuse
+// an existing client, or to use a custom endpoint. Defaults to a Retriever for the region
+// in the secret's ARN.
+func WithRetriever(r *sm.Retriever) Option {
+	return func(o *options) {
+		o.retrieve = r.RetrieveValue
+	}
+}
+
+// WithRetrieveFunc sets the function used to get secrets, e.g. the RetrieveValue method of an
+// sm.V2Retriever, to use aws-sdk-go-v2.
+func WithRetrieveFunc(f sm.RetrieveFunc) Option {
+	return func(o *options) {
+		o.retrieve = f
+	}
+}
+
+// WithHostKey sets the field of the RDS secret which contains the host to connect to, e.g.
+// to connect via an RDS Proxy or reader endpoint stored in the secret as "proxyHost".
+// Defaults to "host". Only used by the RDS store.
+func WithHostKey(key string) Option {
+	return func(o *options) {
+		o.hostKey = key
+	}
+}
+
+// WithFieldMapping sets where each field of the credentials is read from within the secret,
+// for secrets which don't use the RDS layout. Only used by the RDS store.
+func WithFieldMapping(m FieldMapping) Option {
+	return func(o *options) {
+		o.mapping = m
+	}
+}
+
+// WithDSNTemplate sets a template, created with NewDSNTemplate, which renders the
+// connection string returned by the RDS store instead of the engine's default format.
+func WithDSNTemplate(t *template.Template) Option {
+	return func(o *options) {
+		o.dsnTemplate = t
+	}
+}
+
+// WithCABundle sets the PEM encoded certificates used to verify the database server,
+// instead of the bundled RDS certificates. Only used by the RDS store.
+func WithCABundle(pem []byte) Option {
+	return func(o *options) {
+		o.tls.caBundle = pem
+	}
+}
+
+// WithServerName sets the name used to verify the database server's certificate, e.g. when
+// connecting via an RDS Proxy or a custom DNS name which isn't in the certificate. Defaults
+// to the host. Only used by the RDS store, for MySQL and SQL Server.
+func WithServerName(name string) Option {
+	return func(o *options) {
+		o.tls.serverName = name
+	}
+}
+
+// WithMinTLSVersion sets the minimum TLS version, e.g. tls.VersionTLS13. Only used by the
+// RDS store, for MySQL and SQL Server.
+func WithMinTLSVersion(v uint16) Option {
+	return func(o *options) {
+		o.tls.minVersion = v
+	}
+}
+
+// WithTLSSkipVerify disables verification of the database server's certificate. Only for
+// local development. Only used by the RDS store.
+func WithTLSSkipVerify() Option {
+	return func(o *options) {
+		o.tls.skipVerify = true
+	}
+}
+
+// WithCAExpiryWarning calls warn when a MySQL connection's server certificate was verified
+// by a CA which expires within d, e.g. to log that the CA bundle needs to be updated. Only
+// used by the RDS store.
+func WithCAExpiryWarning(d time.Duration, warn func(c certs.Certificate)) Option {
+	return func(o *options) {
+		o.tls.verifyConnection = certs.ExpiryWarning(d, warn)
+	}
+}
+
+// WithTLSDisabled disables TLS, e.g. to connect to a local database. Only used by the RDS
+// store.
+func WithTLSDisabled() Option {
+	return func(o *options) {
+		o.tls.disabled = true
+	}
+}
@@ -0,0 +1,43 @@
+package store
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// lookup the value at the JSON pointer (RFC 6901), e.g. "/accounts/0/user", within the
+// decoded JSON document. ok is false if the value doesn't exist.
+func lookup(doc interface{}, pointer string) (v interface{}, ok bool, err error) {
+	if pointer == "" {
+		return doc, true, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		err = fmt.Errorf("store: invalid JSON pointer %q", pointer)
+		return
+	}
+	v = doc
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch node := v.(type) {
+		case map[string]interface{}:
+			if v, ok = node[token]; !ok {
+				return
+			}
+		case []interface{}:
+			i, convErr := strconv.Atoi(token)
+			if convErr != nil || i < 0 || i >= len(node) {
+				return nil, false, nil
+			}
+			v = node[i]
+		default:
+			return nil, false, nil
+		}
+	}
+	return v, true, nil
+}
+
+// escapePointerToken escapes a field name for use in a JSON pointer.
+func escapePointerToken(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
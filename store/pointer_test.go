@@ -0,0 +1,51 @@
+package store
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	var doc interface{}
+	err := json.Unmarshal([]byte(`{ "user": "a", "db": { "pass": "b" }, "accounts": [ { "user": "c" } ], "a/b": "d", "m~n": "e" }`), &doc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tests := []struct {
+		pointer    string
+		expected   interface{}
+		expectedOK bool
+	}{
+		{pointer: "/user", expected: "a", expectedOK: true},
+		{pointer: "/db/pass", expected: "b", expectedOK: true},
+		{pointer: "/accounts/0/user", expected: "c", expectedOK: true},
+		{pointer: "/a~1b", expected: "d", expectedOK: true},
+		{pointer: "/m~0n", expected: "e", expectedOK: true},
+		{pointer: "/missing", expectedOK: false},
+		{pointer: "/accounts/1/user", expectedOK: false},
+		{pointer: "/user/child", expectedOK: false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.pointer, func(t *testing.T) {
+			t.Parallel()
+			v, ok, err := lookup(doc, test.pointer)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ok != test.expectedOK {
+				t.Errorf("expected ok to be %v, got %v", test.expectedOK, ok)
+			}
+			if !reflect.DeepEqual(v, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, v)
+			}
+		})
+	}
+}
+
+func TestLookupInvalidPointer(t *testing.T) {
+	if _, _, err := lookup(map[string]interface{}{}, "user"); err == nil {
+		t.Error("expected an error")
+	}
+}
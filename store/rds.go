@@ -1,102 +1,218 @@
 package store
 
 import (
-	"crypto/tls"
-	"crypto/x509"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"strconv"
+	"context"
 	"sync"
+	"sync/atomic"
+	"text/template"
 
-	"github.com/a-h/go-sql-driver-rds-credentials/store/certs"
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
 
 	"github.com/go-sql-driver/mysql"
 )
 
 type secretGetter interface {
-	Get(force bool) (secret string, err error)
+	GetValue(ctx context.Context, force bool) (v sm.Value, err error)
+	GetStageValue(ctx context.Context, stage string, force bool) (v sm.Value, err error)
+	Snapshot() Snapshot
 	CallsMade() int
+	Start()
+	Close() error
 }
 
-// RDS store, backed by AWS Secrets Manager.
+// RDS store, backed by AWS Secrets Manager. It's safe for concurrent use.
 type RDS struct {
-	child    secretGetter
-	config   *mysql.Config
-	previous string
-	m        *sync.Mutex
-	dsn      string
+	name  string
+	child secretGetter
+	// config is the MySQL configuration, without credentials. It's never modified after
+	// NewRDS returns.
+	config *mysql.Config
+	// current is the *rdsSnapshot of the current version of the secret. Readers load it
+	// without locking, and it's replaced, rather than modified, when the secret changes.
+	current atomic.Value
+	// m prevents concurrent writes to the CA file.
+	m *sync.Mutex
+	// caFile is the path of the CA bundle, written on demand for drivers which
+	// need to read it from disk. It's shared by stores with the same bundle.
+	caFile      string
+	mapping     FieldMapping
+	dsnTemplate *template.Template
+	tls         tlsOptions
+	observers   observers
 }
 
 // NewRDS creates a new RDS store, passing the name of the secret, and a template DSN.
 // user:password@tcp(host:port)/dbname?parseTime=true&multiStatements=true&collation=utf8mb4_unicode_ci
-func NewRDS(name, dbName string, params map[string]string) (rds *RDS, err error) {
+// If dbName is empty, the "dbname" field of the secret is used.
+// Connections verify the server's certificate against the RDS CA bundle for the region of
+// the secret's ARN (or the commercial regions if the name isn't an ARN), unless
+// configured otherwise with options such as WithCABundle or WithTLSDisabled. The MySQL TLS
+// configuration is registered under a name unique to the store, and deregistered by Close.
+func NewRDS(name, dbName string, params map[string]string, opts ...Option) (rds *RDS, err error) {
+	o := newOptions(opts)
 	conf := mysql.NewConfig()
 	conf.DBName = dbName
-	conf.Params = params
-
-	// Load the TLS certificates.
-	var pem []byte
-	pem, err = certs.Load()
-	if err != nil {
-		err = fmt.Errorf("store: could not load certificates: %v", err)
-		return
+	// Copy the params, so that the caller's map isn't modified. TLS is configured using
+	// options rather than the "tls" param.
+	conf.Params = make(map[string]string, len(params))
+	for k, v := range params {
+		if k == "tls" {
+			continue
+		}
+		conf.Params[k] = v
 	}
-	rcp := x509.NewCertPool()
-	if ok := rcp.AppendCertsFromPEM(pem); !ok {
-		err = errors.New("store: could not append certificates from PEM")
+	o.tls.secretARN = name
+	conf.TLSConfig, conf.TLS, err = registerTLSConfig(o.tls)
+	if err != nil {
 		return
 	}
-	mysql.RegisterTLSConfig("rds", &tls.Config{
-		RootCAs: rcp,
-	})
-	conf.Params["tls"] = "rds"
 
 	rds = &RDS{
-		child:  New(name),
-		config: conf,
-		m:      &sync.Mutex{},
+		name:        name,
+		child:       New(name, opts...),
+		config:      conf,
+		m:           &sync.Mutex{},
+		mapping:     o.mapping.withDefaults(o.hostKey),
+		dsnTemplate: o.dsnTemplate,
+		tls:         o.tls,
+		observers:   o.observers,
 	}
 	return
 }
 
+// rdsSnapshot is an immutable view of a version of the secret.
+type rdsSnapshot struct {
+	secret      string
+	credentials Credentials
+	dsn         string
+}
+
 // Get the secret, optionally forcing a refresh.
 func (s *RDS) Get(force bool) (secret string, err error) {
-	j, err := s.child.Get(force)
+	return s.GetContext(context.Background(), force)
+}
+
+// GetContext gets the secret, optionally forcing a refresh, respecting the context's
+// cancellation and deadline.
+func (s *RDS) GetContext(ctx context.Context, force bool) (secret string, err error) {
+	snapshot, err := s.snapshot(ctx, force)
 	if err != nil {
 		return
 	}
-	if j == s.previous {
+	return snapshot.dsn, nil
+}
+
+// snapshot gets the current version of the secret, only parsing it if it has changed.
+func (s *RDS) snapshot(ctx context.Context, force bool) (snapshot *rdsSnapshot, err error) {
+	v, err := s.child.GetValue(ctx, force)
+	if err != nil {
+		return
+	}
+	snapshot, err = s.snapshotOf(v)
+	if err != nil {
+		s.observers.observe(ParseFailed{Secret: s.name, Err: err})
+	}
+	return
+}
+
+// snapshotOf returns the snapshot of the value, only parsing it if it has changed.
+func (s *RDS) snapshotOf(v sm.Value) (snapshot *rdsSnapshot, err error) {
+	if snapshot, ok := s.current.Load().(*rdsSnapshot); ok && snapshot.secret == v.SecretString &&
+		snapshot.credentials.VersionID == v.VersionID {
 		// Don't bother unmarshalling from JSON if nothing has changed.
-		return s.dsn, nil
+		return snapshot, nil
+	}
+	c, doc, err := s.parse(v)
+	if err != nil {
+		return
+	}
+	// It's changed, so replace the snapshot.
+	dsn, err := s.format(c, doc)
+	if err != nil {
+		return
+	}
+	snapshot = &rdsSnapshot{
+		secret:      v.SecretString,
+		credentials: c,
+		dsn:         dsn,
+	}
+	s.current.Store(snapshot)
+	return snapshot, nil
+}
+
+// cached returns the snapshot of the cached secret, without retrieving it.
+func (s *RDS) cached() (snapshot *rdsSnapshot, ok bool) {
+	cached := s.child.Snapshot()
+	if cached.FetchedAt.IsZero() {
+		return nil, false
+	}
+	snapshot, err := s.snapshotOf(sm.Value{SecretString: cached.Value, VersionID: cached.VersionID})
+	return snapshot, err == nil
+}
+
+// Cached returns the DSN of the cached secret, without retrieving it, or false if the
+// secret hasn't been retrieved, or can't be parsed.
+func (s *RDS) Cached() (dsn string, ok bool) {
+	snapshot, ok := s.cached()
+	if !ok {
+		return "", false
+	}
+	return snapshot.dsn, true
+}
+
+// CachedCredentials returns the credentials of the cached secret, without retrieving it,
+// or false if the secret hasn't been retrieved, or can't be parsed.
+func (s *RDS) CachedCredentials() (c Credentials, ok bool) {
+	snapshot, ok := s.cached()
+	if !ok {
+		return Credentials{}, false
 	}
-	var r rdsSecret
-	err = json.Unmarshal([]byte(j), &r)
+	return snapshot.credentials, true
+}
+
+// GetStage gets the DSN for the version of the secret with the given staging label
+// (e.g. AWSPREVIOUS), optionally forcing a refresh. It doesn't affect the DSN returned
+// by Get.
+func (s *RDS) GetStage(ctx context.Context, stage string, force bool) (secret string, err error) {
+	v, err := s.child.GetStageValue(ctx, stage, force)
 	if err != nil {
 		return
 	}
-	s.previous = j
-	// It's changed, so update the cached dsn.
-	s.m.Lock()
-	defer s.m.Unlock()
-	s.config.User = r.Username
-	s.config.Passwd = r.Password
-	s.config.Net = "tcp"
-	s.config.Addr = r.Host + ":" + strconv.Itoa(r.Port)
-	s.dsn = s.config.FormatDSN()
-	return s.dsn, nil
+	c, doc, err := s.parse(v)
+	if err != nil {
+		return
+	}
+	return s.format(c, doc)
+}
+
+// Credentials gets the database credentials from the secret, optionally forcing a refresh.
+// Unlike Get, the password isn't formatted into a connection string.
+func (s *RDS) Credentials(ctx context.Context, force bool) (c Credentials, err error) {
+	snapshot, err := s.snapshot(ctx, force)
+	if err != nil {
+		return
+	}
+	return snapshot.credentials, nil
+}
+
+// CredentialsStage gets the database credentials from the version of the secret with the
+// given staging label, optionally forcing a refresh.
+func (s *RDS) CredentialsStage(ctx context.Context, stage string, force bool) (c Credentials, err error) {
+	v, err := s.child.GetStageValue(ctx, stage, force)
+	if err != nil {
+		return
+	}
+	c, _, err = s.parse(v)
+	return
+}
+
+// Config returns a copy of the MySQL configuration used to format DSNs, without the
+// credentials, for use with connector.NewMySQL.
+func (s *RDS) Config() *mysql.Config {
+	return s.config.Clone()
 }
 
 // CallsMade to the underlying secret API.
 func (s *RDS) CallsMade() int {
 	return s.child.CallsMade()
 }
-
-type rdsSecret struct {
-	Username            string `json:"username"`
-	Password            string `json:"password"`
-	Engine              string `json:"engine"`
-	Host                string `json:"host"`
-	Port                int    `json:"port"`
-	DbClusterIdentifier string `json:"dbClusterIdentifier"`
-}
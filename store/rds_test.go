@@ -1,8 +1,16 @@
 package store
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/go-sql-driver/mysql"
 )
 
 func TestRDS(t *testing.T) {
@@ -69,7 +77,7 @@ func TestRDS(t *testing.T) {
 				},
 			},
 			previousSecret:      "N/A",
-			expectedSecret:      "user:pwd@tcp(host_name:3306)/databaseName?collation=utf8mb4_unicode_ci&multiStatements=true&parseTime=true&tls=rds",
+			expectedSecret:      "user:pwd@tcp(host_name:3306)/databaseName?tls=TLS_CONFIG&collation=utf8mb4_unicode_ci&multiStatements=true&parseTime=true",
 			expectedSecretCalls: 1,
 			expectedErr:         nil,
 		},
@@ -86,14 +94,17 @@ func TestRDS(t *testing.T) {
 			if err != nil {
 				t.Fatalf("unepxected error creating RDS: %v", err)
 			}
-			rds.previous = test.previousSecret
+			if test.previousSecret != "" {
+				rds.current.Store(&rdsSnapshot{secret: test.previousSecret})
+			}
 			rds.child = test.secret
 			secret, err := rds.Get(false)
 			if !errorsEqual(err, test.expectedErr) {
 				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
 			}
-			if secret != test.expectedSecret {
-				t.Errorf("expected secret '%v', got '%v'", test.expectedSecret, secret)
+			expectedSecret := strings.Replace(test.expectedSecret, "TLS_CONFIG", rds.config.TLSConfig, 1)
+			if secret != expectedSecret {
+				t.Errorf("expected secret '%v', got '%v'", expectedSecret, secret)
 			}
 			if rds.CallsMade() != test.expectedSecretCalls {
 				t.Errorf("expected %d calls, got %d", test.expectedSecretCalls, rds.CallsMade())
@@ -102,6 +113,64 @@ func TestRDS(t *testing.T) {
 	}
 }
 
+func TestRDSGetStage(t *testing.T) {
+	rds, err := NewRDS("secret_ARN", "databaseName", map[string]string{
+		"parseTime": "true",
+	})
+	if err != nil {
+		t.Fatalf("unepxected error creating RDS: %v", err)
+	}
+	rds.child = &mockSecret{
+		GetResults: []SecretGetResult{
+			{
+				Credential: `{ "username": "user", "password": "previous", "engine": "mysql", "host": "host_name", "port": 3306 }`,
+			},
+		},
+	}
+	secret, err := rds.GetStage(context.Background(), "AWSPREVIOUS", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "user:previous@tcp(host_name:3306)/databaseName?tls=" + rds.config.TLSConfig + "&parseTime=true"
+	if secret != expected {
+		t.Errorf("expected secret '%v', got '%v'", expected, secret)
+	}
+	if rds.current.Load() != nil {
+		t.Errorf("expected the current DSN to be unaffected, got '%v'", rds.current.Load())
+	}
+}
+
+func TestRDSCredentials(t *testing.T) {
+	rds, err := NewRDS("secret_ARN", "databaseName", map[string]string{})
+	if err != nil {
+		t.Fatalf("unepxected error creating RDS: %v", err)
+	}
+	rds.child = &mockSecret{
+		VersionID: "version_id",
+		GetResults: []SecretGetResult{
+			{
+				Credential: `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": 3306 }`,
+			},
+		},
+	}
+	c, err := rds.Credentials(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Credentials{
+		Username:  "user",
+		Password:  "pwd",
+		Host:      "host_name",
+		Port:      3306,
+		DBName:    "databaseName",
+		Engine:    "mysql",
+		VersionID: "version_id",
+	}
+	if c != expected {
+		t.Errorf("expected %+v, got %+v", expected, c)
+	}
+}
+
 func errorsEqual(a, b error) bool {
 	if a == nil && b == nil {
 		return true
@@ -116,6 +185,7 @@ func errorsEqual(a, b error) bool {
 }
 
 type mockSecret struct {
+	VersionID      string
 	GetCalls       int
 	GetCallsForced int
 	GetResults     []SecretGetResult
@@ -136,6 +206,146 @@ func (ms *mockSecret) Get(force bool) (credential string, err error) {
 	return
 }
 
+func (ms *mockSecret) GetValue(ctx context.Context, force bool) (v sm.Value, err error) {
+	v.SecretString, err = ms.Get(force)
+	v.VersionID = ms.VersionID
+	return
+}
+
+func (ms *mockSecret) GetStageValue(ctx context.Context, stage string, force bool) (v sm.Value, err error) {
+	return ms.GetValue(ctx, force)
+}
+
+func (ms *mockSecret) Snapshot() Snapshot {
+	return Snapshot{}
+}
+
 func (ms *mockSecret) CallsMade() int {
 	return ms.GetCalls
 }
+
+func (ms *mockSecret) Start() {}
+
+func (ms *mockSecret) Close() error {
+	return nil
+}
+
+func TestRDSConcurrentGet(t *testing.T) {
+	rds, err := NewRDS("secret_ARN", "databaseName", map[string]string{})
+	if err != nil {
+		t.Fatalf("unepxected error creating RDS: %v", err)
+	}
+	child := &rotatingSecret{}
+	rds.child = child
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				force := j%10 == i%10
+				dsn, err := rds.Get(force)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				cfg, err := mysql.ParseDSN(dsn)
+				if err != nil {
+					t.Errorf("unexpected error parsing DSN: %v", err)
+					return
+				}
+				if cfg.User != "user_"+cfg.Passwd {
+					t.Errorf("expected the user and password to be from the same version, got %q and %q", cfg.User, cfg.Passwd)
+				}
+				c, err := rds.Credentials(context.Background(), force)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if c.Username != "user_"+c.Password {
+					t.Errorf("expected the user and password to be from the same version, got %q and %q", c.Username, c.Password)
+				}
+				if _, err = rds.GetStage(context.Background(), "AWSPREVIOUS", false); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				_ = rds.Config()
+			}
+		}(i)
+	}
+	wg.Wait()
+	if child.CallsMade() == 0 {
+		t.Error("expected the secret to be retrieved")
+	}
+}
+
+// rotatingSecret changes the password each time a refresh is forced, and is safe for
+// concurrent use.
+type rotatingSecret struct {
+	m       sync.Mutex
+	version int
+	calls   int
+}
+
+func (rs *rotatingSecret) GetValue(ctx context.Context, force bool) (v sm.Value, err error) {
+	rs.m.Lock()
+	defer rs.m.Unlock()
+	rs.calls++
+	if force {
+		rs.version++
+	}
+	v.VersionID = strconv.Itoa(rs.version)
+	v.SecretString = fmt.Sprintf(`{ "username": "user_%d", "password": "%d", "host": "host_name", "port": 3306 }`, rs.version, rs.version)
+	return
+}
+
+func (rs *rotatingSecret) GetStageValue(ctx context.Context, stage string, force bool) (v sm.Value, err error) {
+	return rs.GetValue(ctx, force)
+}
+
+func (rs *rotatingSecret) Snapshot() Snapshot {
+	return Snapshot{}
+}
+
+func (rs *rotatingSecret) CallsMade() int {
+	rs.m.Lock()
+	defer rs.m.Unlock()
+	return rs.calls
+}
+
+func (rs *rotatingSecret) Start() {}
+
+func (rs *rotatingSecret) Close() error {
+	return nil
+}
+
+func TestRDSCached(t *testing.T) {
+	var calls int
+	s, err := NewRDS("secret_ARN", "databaseName", nil, WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		calls++
+		return sm.Value{SecretString: `{ "username": "user", "password": "pwd", "host": "host_name", "port": 3306 }`, VersionID: "v1"}, nil
+	}))
+	if err != nil {
+		t.Fatalf("unepxected error creating RDS: %v", err)
+	}
+	defer s.Close()
+	if _, ok := s.Cached(); ok {
+		t.Error("expected no cached DSN before the secret is retrieved")
+	}
+	if _, ok := s.CachedCredentials(); ok {
+		t.Error("expected no cached credentials before the secret is retrieved")
+	}
+	dsn, err := s.Get(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached, ok := s.Cached(); !ok || cached != dsn {
+		t.Errorf("expected the cached DSN %q, got %q, %v", dsn, cached, ok)
+	}
+	if c, ok := s.CachedCredentials(); !ok || c.Username != "user" || c.VersionID != "v1" {
+		t.Errorf("expected the cached credentials, got %v, %v", c, ok)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single call, got %d", calls)
+	}
+}
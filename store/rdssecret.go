@@ -0,0 +1,84 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+const defaultHostKey = "host"
+
+// parse the secret JSON, reading each field of the credentials from the location given
+// by the field mapping, which defaults to the RDS secret layout:
+// { "username": "", "password": "", "engine": "", "host": "", "port": 3306, "dbname": "" }
+func (s *RDS) parse(v sm.Value) (c Credentials, doc interface{}, err error) {
+	err = json.Unmarshal([]byte(v.SecretString), &doc)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			c, doc = Credentials{}, nil
+		}
+	}()
+	fields := []struct {
+		pointer  string
+		required bool
+		value    *string
+	}{
+		{pointer: s.mapping.Username, required: true, value: &c.Username},
+		{pointer: s.mapping.Password, required: true, value: &c.Password},
+		{pointer: s.mapping.Host, required: true, value: &c.Host},
+		{pointer: s.mapping.DBName, value: &c.DBName},
+		{pointer: s.mapping.Engine, value: &c.Engine},
+	}
+	for _, f := range fields {
+		var value interface{}
+		var ok bool
+		value, ok, err = lookup(doc, f.pointer)
+		if err != nil {
+			return
+		}
+		if str, isString := value.(string); ok && isString {
+			*f.value = str
+		}
+		if f.required && *f.value == "" {
+			err = fmt.Errorf("store: RDS secret is missing the %q field", strings.TrimPrefix(f.pointer, "/"))
+			return
+		}
+	}
+	c.Port, err = s.parsePort(doc)
+	if err != nil {
+		return
+	}
+	if c.Port == 0 {
+		c.Port = defaultPorts[c.Engine]
+	}
+	if s.config.DBName != "" {
+		c.DBName = s.config.DBName
+	}
+	c.VersionID = v.VersionID
+	return
+}
+
+// parsePort reads the port from a JSON number or string, since secrets created outside of
+// the RDS console sometimes contain "port": "3306".
+func (s *RDS) parsePort(doc interface{}) (port int, err error) {
+	value, _, err := lookup(doc, s.mapping.Port)
+	if err != nil || value == nil {
+		return
+	}
+	switch p := value.(type) {
+	case float64:
+		return int(p), nil
+	case string:
+		if port, err = strconv.Atoi(p); err == nil {
+			return
+		}
+	}
+	b, _ := json.Marshal(value)
+	return 0, fmt.Errorf("store: invalid port %s", b)
+}
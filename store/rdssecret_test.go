@@ -0,0 +1,134 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+func TestRDSSecretParsing(t *testing.T) {
+	tests := []struct {
+		name        string
+		dbName      string
+		opts        []Option
+		secret      string
+		expected    Credentials
+		expectedErr error
+	}{
+		{
+			name:   "the dbname field is used if the database name isn't passed to NewRDS",
+			secret: `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": 3306, "dbname": "secretDB" }`,
+			expected: Credentials{
+				Username: "user",
+				Password: "pwd",
+				Host:     "host_name",
+				Port:     3306,
+				DBName:   "secretDB",
+				Engine:   "mysql",
+			},
+		},
+		{
+			name:   "the database name passed to NewRDS overrides the dbname field",
+			dbName: "databaseName",
+			secret: `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": 3306, "dbname": "secretDB" }`,
+			expected: Credentials{
+				Username: "user",
+				Password: "pwd",
+				Host:     "host_name",
+				Port:     3306,
+				DBName:   "databaseName",
+				Engine:   "mysql",
+			},
+		},
+		{
+			name:   "missing ports default according to the engine",
+			secret: `{ "username": "user", "password": "pwd", "engine": "postgres", "host": "host_name" }`,
+			expected: Credentials{
+				Username: "user",
+				Password: "pwd",
+				Host:     "host_name",
+				Port:     5432,
+				Engine:   "postgres",
+			},
+		},
+		{
+			name:   "ports can be strings",
+			secret: `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": "3307" }`,
+			expected: Credentials{
+				Username: "user",
+				Password: "pwd",
+				Host:     "host_name",
+				Port:     3307,
+				Engine:   "mysql",
+			},
+		},
+		{
+			name:   "the host can be read from another field",
+			opts:   []Option{WithHostKey("proxyHost")},
+			secret: `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "proxyHost": "proxy_name", "port": 3306 }`,
+			expected: Credentials{
+				Username: "user",
+				Password: "pwd",
+				Host:     "proxy_name",
+				Port:     3306,
+				Engine:   "mysql",
+			},
+		},
+		{
+			name: "fields can be mapped using JSON pointers",
+			opts: []Option{WithFieldMapping(FieldMapping{
+				Username: "/accounts/1/user",
+				Password: "/accounts/1/pass",
+				Host:     "/endpoint/address",
+				Port:     "/endpoint/port",
+			})},
+			secret: `{ "accounts": [ { "user": "admin", "pass": "admin_pwd" }, { "user": "app", "pass": "app_pwd" } ], "endpoint": { "address": "host_name", "port": 3307 } }`,
+			expected: Credentials{
+				Username: "app",
+				Password: "app_pwd",
+				Host:     "host_name",
+				Port:     3307,
+			},
+		},
+		{
+			name:        "missing mapped fields are reported using the pointer",
+			opts:        []Option{WithFieldMapping(FieldMapping{Password: "/db/pass"})},
+			secret:      `{ "username": "user", "password": "pwd", "host": "host_name" }`,
+			expectedErr: errors.New(`store: RDS secret is missing the "db/pass" field`),
+		},
+		{
+			name:        "missing fields are reported",
+			secret:      `{ "username": "user", "engine": "mysql", "host": "host_name", "port": 3306 }`,
+			expectedErr: errors.New(`store: RDS secret is missing the "password" field`),
+		},
+		{
+			name:        "missing host fields are reported using the configured key",
+			opts:        []Option{WithHostKey("proxyHost")},
+			secret:      `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": 3306 }`,
+			expectedErr: errors.New(`store: RDS secret is missing the "proxyHost" field`),
+		},
+		{
+			name:        "invalid ports are reported",
+			secret:      `{ "username": "user", "password": "pwd", "engine": "mysql", "host": "host_name", "port": "abc" }`,
+			expectedErr: errors.New(`store: invalid port "abc"`),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			rds, err := NewRDS("secret_ARN", test.dbName, map[string]string{}, test.opts...)
+			if err != nil {
+				t.Fatalf("unepxected error creating RDS: %v", err)
+			}
+			c, _, err := rds.parse(sm.Value{SecretString: test.secret})
+			if !errorsEqual(err, test.expectedErr) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if c != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, c)
+			}
+		})
+	}
+}
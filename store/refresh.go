@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// refreshRetryInterval is how long the background refresher waits before trying again
+// after failing to retrieve the secret.
+const refreshRetryInterval = time.Second * 30
+
+// minRefreshInterval is the minimum time between background refreshes, so that the
+// refresher can't call Secrets Manager in a loop, e.g. if the cache duration is shorter
+// than the refresh before duration.
+const minRefreshInterval = time.Second
+
+// refresher is a running background refresh goroutine.
+type refresher struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Start a background goroutine which retrieves the secret ahead of the cache expiring (see
+// WithRefreshBefore and WithRefreshJitter), so that calls to Get are served from the
+// cache. Readers are not blocked while the secret is being retrieved. Calling Start on a
+// store which has already been started has no effect. Call Close to stop the goroutine.
+func (s *Secret) Start() {
+	if err := s.lock(context.Background()); err != nil {
+		return
+	}
+	defer s.unlock()
+	if s.refresher != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.refresher = &refresher{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go s.refreshLoop(ctx, s.refresher.done)
+}
+
+// Close stops the background refresher started by Start, and waits for it to exit.
+func (s *Secret) Close() error {
+	if err := s.lock(context.Background()); err != nil {
+		return err
+	}
+	r := s.refresher
+	s.refresher = nil
+	s.unlock()
+	if r == nil {
+		return nil
+	}
+	r.cancel()
+	<-r.done
+	return nil
+}
+
+func (s *Secret) refreshLoop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	wait, err := s.untilRefresh(ctx)
+	for err == nil {
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+		if err = s.refreshInBackground(ctx); err != nil {
+			wait = refreshRetryInterval + s.jitter()
+			err = ctx.Err()
+			continue
+		}
+		wait, err = s.untilRefresh(ctx)
+	}
+}
+
+// untilRefresh returns the time to wait before refreshing the secret.
+func (s *Secret) untilRefresh(ctx context.Context) (wait time.Duration, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	snapshot := s.Snapshot()
+	refreshAt := snapshot.ExpiresAt.Add(-s.refreshBefore).Add(-s.jitter())
+	// Don't refresh before half of the cache duration has passed.
+	if earliest := snapshot.FetchedAt.Add(s.cacheFor / 2); refreshAt.Before(earliest) {
+		refreshAt = earliest
+	}
+	wait = time.Until(refreshAt)
+	if wait < minRefreshInterval {
+		wait = minRefreshInterval
+	}
+	return
+}
+
+func (s *Secret) jitter() time.Duration {
+	if s.refreshJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.refreshJitter)))
+}
+
+// refreshInBackground retrieves the secret without holding the lock, so that readers
+// continue to be served the cached value, then updates the cache. If the secret was
+// retrieved by another caller in the meantime, e.g. by a forced refresh after a rotation,
+// the result is discarded, because it may be older.
+func (s *Secret) refreshInBackground(ctx context.Context) (err error) {
+	generation := atomic.LoadUint64(&s.generation)
+	v, err := s.fetch(ctx, sm.StageCurrent, false, true)
+	if err != nil {
+		s.setLastError(ctx, err)
+		return
+	}
+	s.setLastError(ctx, nil)
+	if err = s.lock(ctx); err != nil {
+		return
+	}
+	defer s.unlock()
+	if atomic.LoadUint64(&s.generation) != generation {
+		return
+	}
+	s.update(v, time.Now().UTC())
+	return
+}
+
+// Start the background refresh of the underlying secret. See Secret.Start.
+func (s *RDS) Start() {
+	s.child.Start()
+}
+
+// Close stops the background refresh of the underlying secret, and deregisters the
+// store's MySQL TLS configuration.
+func (s *RDS) Close() error {
+	if s.config.TLSConfig != "" {
+		mysql.DeregisterTLSConfig(s.config.TLSConfig)
+	}
+	return s.child.Close()
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+func TestBackgroundRefresh(t *testing.T) {
+	var calls int64
+	s := New("secret_ARN",
+		WithCacheFor(time.Millisecond*100),
+		WithRefreshBefore(time.Millisecond*50),
+		WithRefreshJitter(time.Millisecond*10))
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		atomic.AddInt64(&calls, 1)
+		return "expected_secret", nil
+	})
+	s.Start()
+	// Starting twice has no effect.
+	s.Start()
+	deadline := time.Now().Add(time.Second * 5)
+	for atomic.LoadInt64(&calls) < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the secret to be refreshed in the background, got %d calls", atomic.LoadInt64(&calls))
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+	stoppedAt := atomic.LoadInt64(&calls)
+	time.Sleep(time.Millisecond * 200)
+	if after := atomic.LoadInt64(&calls); after != stoppedAt {
+		t.Errorf("expected no refreshes after Close, got %d more", after-stoppedAt)
+	}
+	if s.CallsMade() != int(stoppedAt) {
+		t.Errorf("expected CallsMade to be %d, got %d", stoppedAt, s.CallsMade())
+	}
+}
+
+func TestBackgroundRefreshDoesNotBlockReaders(t *testing.T) {
+	// The refresh starts halfway through the cache duration, before the cached value expires.
+	s := New("secret_ARN", WithCacheFor(time.Second*2), WithRefreshJitter(0))
+	s.update(sm.Value{SecretString: "cached_secret"}, time.Now().UTC())
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		once.Do(func() {
+			close(started)
+			<-release
+		})
+		return "new_secret", nil
+	})
+	s.Start()
+	defer s.Close()
+	<-started
+	secret, err := s.Get(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secret != "cached_secret" {
+		t.Errorf("expected the cached secret while refreshing, got '%v'", secret)
+	}
+	close(release)
+}
+
+func TestBackgroundRefreshDoesNotOverwriteANewerValue(t *testing.T) {
+	s := New("secret_ARN", WithRefreshJitter(0))
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls int64
+	s.retrieve = func(ctx context.Context, arn, stage string) (sm.Value, error) {
+		if atomic.AddInt64(&calls, 1) == 1 {
+			// The background refresh retrieves the old version, but returns after the
+			// forced refresh.
+			close(started)
+			<-release
+			return sm.Value{SecretString: "old_secret", VersionID: "v1"}, nil
+		}
+		return sm.Value{SecretString: "new_secret", VersionID: "v2"}, nil
+	}
+	done := make(chan error)
+	go func() {
+		done <- s.refreshInBackground(context.Background())
+	}()
+	<-started
+	secret, _, err := s.Refresh(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secret != "new_secret" {
+		t.Fatalf("expected the new secret to be retrieved, got '%v'", secret)
+	}
+	close(release)
+	if err = <-done; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	secret, err = s.Get(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secret != "new_secret" {
+		t.Errorf("expected the background refresh to be discarded, got '%v'", secret)
+	}
+}
+
+func TestBackgroundRefreshWithShortCacheDuration(t *testing.T) {
+	var calls int64
+	// The cache expires before the refresh before duration, so every refresh is overdue.
+	s := New("secret_ARN",
+		WithCacheFor(time.Millisecond*10),
+		WithRefreshBefore(time.Minute*5),
+		WithRefreshJitter(0))
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		atomic.AddInt64(&calls, 1)
+		return "expected_secret", nil
+	})
+	s.Start()
+	time.Sleep(minRefreshInterval + minRefreshInterval/2)
+	if err := s.Close(); err != nil {
+		t.Fatalf("unexpected error closing: %v", err)
+	}
+	if n := atomic.LoadInt64(&calls); n != 1 {
+		t.Errorf("expected a single refresh, got %d", n)
+	}
+}
+
+func TestCloseWithoutStart(t *testing.T) {
+	s := New("secret_ARN")
+	if err := s.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
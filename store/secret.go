@@ -1,53 +1,343 @@
 package store
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// Secret store, backed by AWS Secrets Manager.
+// Secret store, backed by AWS Secrets Manager. It's safe for concurrent use. The cached
+// value is read without locking, so readers aren't blocked while the secret is refreshed.
 type Secret struct {
-	Name          string
-	CacheFor      time.Duration
-	LastRefreshed time.Time
-	m             *sync.Mutex
-	retrieve      func(name string) (secret string, err error)
-	Value         string
-	callsMade     int
+	name string
+	// cacheFor is how long the secret is cached. See WithCacheFor.
+	cacheFor time.Duration
+	// refreshBefore and refreshJitter control the background refresher. See Start.
+	refreshBefore time.Duration
+	refreshJitter time.Duration
+	// maxStaleness is how long after the cache expires that the cached value continues to
+	// be returned if the secret can't be retrieved. See WithMaxStaleness.
+	maxStaleness time.Duration
+	// errorBackoff is how long to wait after failing to retrieve the secret before trying
+	// again. See WithErrorBackoff.
+	errorBackoff time.Duration
+	// minForceInterval is the minimum time between forced refreshes. See
+	// WithMinForceInterval.
+	minForceInterval time.Duration
+	// current is the *Snapshot of the cached value. It's replaced, rather than modified,
+	// when the secret is retrieved.
+	current atomic.Value
+	// m is held while the secret is being retrieved, so that only one refresh runs at a
+	// time. Readers of a cached value don't wait for it.
+	m         chan struct{}
+	retrieve  sm.RetrieveFunc
+	observers observers
+	tracer    trace.Tracer
+	stages    map[string]*stagedValue
+	callsMade int64
+	refresher *refresher
+	// generation is incremented each time the secret is retrieved. It's used to coalesce
+	// forced refreshes which were waiting while another refresh took place.
+	generation  uint64
+	lastChanged bool
+	// errM protects lastErr and lastErrAt, so that they can be read while a refresh is
+	// in progress.
+	errM      *sync.Mutex
+	lastErr   error
+	lastErrAt time.Time
+}
+
+// Snapshot of the cached secret.
+type Snapshot struct {
+	Value     string
+	VersionID string
+	// CreatedDate is when the version was created, if the retriever provides it.
+	CreatedDate time.Time
+	// FetchedAt is when the secret was retrieved from Secrets Manager.
+	FetchedAt time.Time
+	// ExpiresAt is when the cached value expires, and the secret will be retrieved again.
+	ExpiresAt time.Time
+}
+
+// stagedValue is a cached version of the secret other than AWSCURRENT.
+type stagedValue struct {
+	value         sm.Value
+	lastRefreshed time.Time
 }
 
 const defaultCacheDuration = time.Hour * 24
+const defaultRefreshBefore = time.Minute * 5
+const defaultRefreshJitter = time.Minute
+const defaultMaxStaleness = time.Hour
+const defaultErrorBackoff = time.Second * 5
+const defaultMinForceInterval = time.Second * 5
 
 // New creates a new store.
-func New(name string) *Secret {
+func New(name string, opts ...Option) *Secret {
+	o := newOptions(opts)
 	return &Secret{
-		Name:          name,
-		CacheFor:      defaultCacheDuration,
-		LastRefreshed: time.Time{},
-		m:             &sync.Mutex{},
-		retrieve:      sm.DefaultRetrieve,
+		name:             name,
+		cacheFor:         o.cacheFor,
+		refreshBefore:    o.refreshBefore,
+		refreshJitter:    o.refreshJitter,
+		maxStaleness:     o.maxStaleness,
+		errorBackoff:     o.errorBackoff,
+		minForceInterval: o.minForceInterval,
+		errM:             &sync.Mutex{},
+		m:                make(chan struct{}, 1),
+		retrieve:         sm.WithRetry(o.observers.onRetry(o.retryPolicy), o.observers.onCall(traceRetrieve(o.tracer, o.retrieve))),
+		observers:        o.observers,
+		tracer:           o.tracer,
+		stages:           make(map[string]*stagedValue),
 	}
 }
 
+// Name of the secret.
+func (s *Secret) Name() string {
+	return s.name
+}
+
+// Snapshot returns the cached secret, without retrieving it. The snapshot is zero if the
+// secret hasn't been retrieved.
+func (s *Secret) Snapshot() Snapshot {
+	if snapshot := s.snapshot(); snapshot != nil {
+		return *snapshot
+	}
+	return Snapshot{}
+}
+
+// Cached returns the cached secret, without retrieving it, or false if the secret hasn't
+// been retrieved. The value may have expired.
+func (s *Secret) Cached() (secret string, ok bool) {
+	snapshot := s.snapshot()
+	if snapshot == nil {
+		return "", false
+	}
+	return snapshot.Value, true
+}
+
+func (s *Secret) snapshot() *Snapshot {
+	snapshot, _ := s.current.Load().(*Snapshot)
+	return snapshot
+}
+
 // Get the secret, optionally forcing a refresh.
 func (s *Secret) Get(force bool) (secret string, err error) {
-	s.m.Lock()
-	defer s.m.Unlock()
-	if force || time.Now().UTC().After(s.LastRefreshed.Add(s.CacheFor)) {
-		secret, err = s.retrieve(s.Name)
-		if err != nil {
-			return
+	return s.GetContext(context.Background(), force)
+}
+
+// GetContext gets the secret, optionally forcing a refresh. If the context is cancelled
+// while waiting for another refresh to complete, or while the secret is being retrieved,
+// the context's error is returned.
+//
+// If the secret can't be retrieved, or another caller is already retrieving it, the cached
+// value is returned for up to the max staleness (see WithMaxStaleness) after it expires,
+// unless the refresh was forced.
+func (s *Secret) GetContext(ctx context.Context, force bool) (secret string, err error) {
+	v, _, err := s.get(ctx, force)
+	return v.SecretString, err
+}
+
+// GetValue gets the secret and its version ID, optionally forcing a refresh. See GetContext.
+func (s *Secret) GetValue(ctx context.Context, force bool) (v sm.Value, err error) {
+	v, _, err = s.get(ctx, force)
+	return
+}
+
+// Refresh forces a refresh of the secret, returning whether the value changed. Concurrent
+// calls to Refresh result in a single call to Secrets Manager, and calls within the
+// minimum interval (see WithMinForceInterval) of the last refresh return the cached value
+// without a call.
+func (s *Secret) Refresh(ctx context.Context) (secret string, changed bool, err error) {
+	v, changed, err := s.get(ctx, true)
+	return v.SecretString, changed, err
+}
+
+func (s *Secret) get(ctx context.Context, force bool) (v sm.Value, changed bool, err error) {
+	ctx, span := s.tracer.Start(ctx, "store.Get",
+		trace.WithAttributes(attrSecretID.String(s.name), attrStage.String(sm.StageCurrent), attrForced.Bool(force)))
+	var fetched, stale bool
+	defer func() {
+		span.SetAttributes(attrCacheHit.Bool(!fetched && !stale), attrStale.Bool(stale))
+		endSpan(span, err)
+	}()
+	generation := atomic.LoadUint64(&s.generation)
+	now := time.Now().UTC()
+	if !force {
+		if snapshot := s.snapshot(); snapshot != nil && !now.After(snapshot.ExpiresAt) {
+			s.observers.observe(CacheHit{Secret: s.name, Stage: sm.StageCurrent})
+			return snapshot.value(), false, nil
+		}
+		s.observers.observe(CacheMiss{Secret: s.name, Stage: sm.StageCurrent})
+		if lastErr := s.recentError(now); lastErr != nil {
+			if snapshot := s.snapshot(); s.canServeStale(snapshot, now) {
+				stale = true
+				s.observers.observe(StaleValueServed{Secret: s.name, Err: lastErr})
+				return snapshot.value(), false, nil
+			}
+			return sm.Value{}, false, lastErr
+		}
+		if !s.tryLock() {
+			// Another refresh is in progress, so serve the stale value rather than wait.
+			if snapshot := s.snapshot(); s.canServeStale(snapshot, now) {
+				stale = true
+				s.observers.observe(StaleValueServed{Secret: s.name})
+				return snapshot.value(), false, nil
+			}
+			if err = s.lock(ctx); err != nil {
+				return
+			}
+		}
+	} else if err = s.lock(ctx); err != nil {
+		return
+	}
+	defer s.unlock()
+	snapshot := s.snapshot()
+	if atomic.LoadUint64(&s.generation) != generation {
+		// Another caller refreshed the secret while this one was waiting for the lock. If
+		// the refresh wasn't forced, the cache miss has already been observed.
+		if force {
+			s.observers.observe(ForcedRefreshSkipped{Secret: s.name, Stage: sm.StageCurrent})
+		}
+		return snapshot.value(), s.lastChanged, nil
+	}
+	if force && s.minForceInterval > 0 && snapshot != nil && now.Before(snapshot.FetchedAt.Add(s.minForceInterval)) {
+		s.observers.observe(ForcedRefreshSkipped{Secret: s.name, Stage: sm.StageCurrent, RateLimited: true})
+		return snapshot.value(), false, nil
+	}
+	fetched = true
+	v, err = s.fetch(ctx, sm.StageCurrent, force, false)
+	if err != nil {
+		s.setLastError(ctx, err)
+		if !force && s.canServeStale(snapshot, now) {
+			stale = true
+			s.observers.observe(StaleValueServed{Secret: s.name, Err: err})
+			return snapshot.value(), false, nil
 		}
-		s.callsMade++
-		s.Value = secret
-		s.LastRefreshed = time.Now().UTC()
+		return
 	}
-	return s.Value, nil
+	s.setLastError(ctx, nil)
+	return v, s.update(v, time.Now().UTC()), nil
+}
+
+// fetch retrieves the secret from Secrets Manager, observing the refresh.
+func (s *Secret) fetch(ctx context.Context, stage string, forced, background bool) (v sm.Value, err error) {
+	ctx, span := s.tracer.Start(ctx, "store.Fetch",
+		trace.WithAttributes(attrSecretID.String(s.name), attrStage.String(stage), attrForced.Bool(forced), attrBackground.Bool(background)))
+	defer func() { endSpan(span, err) }()
+	s.observers.observe(RefreshStarted{Secret: s.name, Stage: stage, Forced: forced, Background: background})
+	start := time.Now()
+	v, err = s.retrieve(ctx, s.name, stage)
+	if err != nil {
+		s.observers.observe(RefreshFailed{Secret: s.name, Stage: stage, Background: background, Duration: time.Since(start), Err: err})
+		return
+	}
+	atomic.AddInt64(&s.callsMade, 1)
+	s.observers.observe(RefreshSucceeded{Secret: s.name, Stage: stage, VersionID: v.VersionID, CreatedDate: v.CreatedDate, Background: background, Duration: time.Since(start)})
+	return
+}
+
+func (snapshot *Snapshot) value() sm.Value {
+	if snapshot == nil {
+		return sm.Value{}
+	}
+	return sm.Value{
+		SecretString: snapshot.Value,
+		VersionID:    snapshot.VersionID,
+		CreatedDate:  snapshot.CreatedDate,
+	}
+}
+
+// update the cached value, returning whether it changed. Must be called with the lock held.
+func (s *Secret) update(v sm.Value, fetchedAt time.Time) (changed bool) {
+	previous := s.snapshot()
+	changed = previous == nil || v.SecretString != previous.Value
+	s.current.Store(&Snapshot{
+		Value:       v.SecretString,
+		VersionID:   v.VersionID,
+		CreatedDate: v.CreatedDate,
+		FetchedAt:   fetchedAt,
+		ExpiresAt:   fetchedAt.Add(s.cacheFor),
+	})
+	s.lastChanged = changed
+	atomic.AddUint64(&s.generation, 1)
+	if previous != nil && (changed || v.VersionID != previous.VersionID) {
+		s.observers.observe(VersionChanged{Secret: s.name, PreviousVersionID: previous.VersionID, VersionID: v.VersionID})
+	}
+	return
+}
+
+// GetStage gets the version of the secret with the given staging label (e.g. AWSPREVIOUS),
+// optionally forcing a refresh. Each stage is cached separately.
+func (s *Secret) GetStage(ctx context.Context, stage string, force bool) (secret string, err error) {
+	v, err := s.GetStageValue(ctx, stage, force)
+	return v.SecretString, err
+}
+
+// GetStageValue gets the version of the secret with the given staging label, and its
+// version ID. See GetStage.
+func (s *Secret) GetStageValue(ctx context.Context, stage string, force bool) (v sm.Value, err error) {
+	if stage == sm.StageCurrent {
+		return s.GetValue(ctx, force)
+	}
+	ctx, span := s.tracer.Start(ctx, "store.GetStage",
+		trace.WithAttributes(attrSecretID.String(s.name), attrStage.String(stage), attrForced.Bool(force)))
+	var fetched bool
+	defer func() {
+		span.SetAttributes(attrCacheHit.Bool(!fetched))
+		endSpan(span, err)
+	}()
+	if err = s.lock(ctx); err != nil {
+		return
+	}
+	defer s.unlock()
+	cached, ok := s.stages[stage]
+	if ok && !force && !time.Now().UTC().After(cached.lastRefreshed.Add(s.cacheFor)) {
+		s.observers.observe(CacheHit{Secret: s.name, Stage: stage})
+		return cached.value, nil
+	}
+	if !force {
+		s.observers.observe(CacheMiss{Secret: s.name, Stage: stage})
+	}
+	fetched = true
+	v, err = s.fetch(ctx, stage, force, false)
+	if err != nil {
+		return
+	}
+	s.stages[stage] = &stagedValue{
+		value:         v,
+		lastRefreshed: time.Now().UTC(),
+	}
+	return v, nil
+}
+
+func (s *Secret) lock(ctx context.Context) error {
+	select {
+	case s.m <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *Secret) tryLock() bool {
+	select {
+	case s.m <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Secret) unlock() {
+	<-s.m
 }
 
 // CallsMade to the underlying secret API.
 func (s *Secret) CallsMade() int {
-	return s.callsMade
+	return int(atomic.LoadInt64(&s.callsMade))
 }
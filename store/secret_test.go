@@ -1,19 +1,28 @@
 package store
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
 )
 
 func TestSecretRetrievalErrors(t *testing.T) {
 	sm := New("secret_ARN")
 	retrievalError := errors.New("retrieval error")
-	sm.retrieve = func(arn string) (secret string, err error) {
+	sm.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
 		if arn != "secret_ARN" {
 			t.Errorf("unexpected ARN: %v", arn)
 		}
 		return "expected_secret", retrievalError
-	}
+	})
 	_, err := sm.Get(true)
 	if err != retrievalError {
 		t.Errorf("expected err: %v, got: %v", retrievalError, err)
@@ -52,14 +61,14 @@ func TestSecret(t *testing.T) {
 			t.Parallel()
 
 			var secretManagerCalls int
-			sm := New("secret_ARN")
-			sm.retrieve = func(arn string) (secret string, err error) {
+			sm := New("secret_ARN", WithMinForceInterval(0))
+			sm.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
 				secretManagerCalls++
 				if arn != "secret_ARN" {
 					t.Errorf("unexpected ARN: %v", arn)
 				}
 				return "expected_secret", nil
-			}
+			})
 			var secret string
 			var err error
 			for _, force := range test.getParameters {
@@ -80,3 +89,276 @@ func TestSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretGetContextCancellation(t *testing.T) {
+	sm := New("secret_ARN")
+	sm.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	_, err := sm.GetContext(ctx, false)
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected err: %v, got: %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestSecretGetContextWaitingForLockIsCancellable(t *testing.T) {
+	sm := New("secret_ARN")
+	sm.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		return "expected_secret", nil
+	})
+	// Simulate another caller holding the lock during a slow refresh.
+	sm.m <- struct{}{}
+	defer func() { <-sm.m }()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := sm.GetContext(ctx, false)
+	if err != context.Canceled {
+		t.Errorf("expected err: %v, got: %v", context.Canceled, err)
+	}
+}
+
+func TestSecretGetStage(t *testing.T) {
+	sm := New("secret_ARN")
+	var stagesRetrieved []string
+	sm.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		stagesRetrieved = append(stagesRetrieved, stage)
+		return "secret_" + stage, nil
+	})
+	ctx := context.Background()
+	for _, force := range []bool{false, false, true} {
+		secret, err := sm.GetStage(ctx, "AWSPREVIOUS", force)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if secret != "secret_AWSPREVIOUS" {
+			t.Errorf("expected secret 'secret_AWSPREVIOUS', got '%v'", secret)
+		}
+	}
+	current, err := sm.GetStage(ctx, "AWSCURRENT", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current != "secret_AWSCURRENT" || sm.Snapshot().Value != current {
+		t.Errorf("expected the current stage to populate the snapshot, got '%v'", current)
+	}
+	expected := []string{"AWSPREVIOUS", "AWSPREVIOUS", "AWSCURRENT"}
+	if !reflect.DeepEqual(stagesRetrieved, expected) {
+		t.Errorf("expected stages %v to be retrieved, got %v", expected, stagesRetrieved)
+	}
+	if sm.CallsMade() != len(expected) {
+		t.Errorf("expected %d calls, got %d", len(expected), sm.CallsMade())
+	}
+}
+
+func TestSecretRefresh(t *testing.T) {
+	values := []string{"first", "first", "second"}
+	var calls int
+	s := New("secret_ARN", WithMinForceInterval(0))
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		secret = values[calls]
+		calls++
+		return
+	})
+	ctx := context.Background()
+	expectedChanged := []bool{true, false, true}
+	for i, expected := range expectedChanged {
+		secret, changed, err := s.Refresh(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if secret != values[i] {
+			t.Errorf("refresh %d: expected secret '%v', got '%v'", i, values[i], secret)
+		}
+		if changed != expected {
+			t.Errorf("refresh %d: expected changed to be %v, got %v", i, expected, changed)
+		}
+	}
+}
+
+func TestSecretRefreshMinForceInterval(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []Option
+		expectedCalls int
+	}{
+		{
+			name:          "forced refreshes are rate limited by default",
+			expectedCalls: 1,
+		},
+		{
+			name:          "forced refreshes are rate limited to the interval",
+			opts:          []Option{WithMinForceInterval(time.Minute)},
+			expectedCalls: 1,
+		},
+		{
+			name:          "forced refreshes always retrieve the secret if the interval is 0",
+			opts:          []Option{WithMinForceInterval(0)},
+			expectedCalls: 3,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int
+			s := New("secret_ARN", test.opts...)
+			s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+				calls++
+				return "expected_secret", nil
+			})
+			for i := 0; i < 3; i++ {
+				if _, err := s.Get(true); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestSecretConcurrentRefreshesAreCoalesced(t *testing.T) {
+	const refreshes = 10
+	var calls int64
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s := New("secret_ARN")
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		if atomic.AddInt64(&calls, 1) == 1 {
+			close(started)
+			<-release
+		}
+		return "expected_secret", nil
+	})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Refresh(context.Background())
+	}()
+	<-started
+	// Start more refreshes while the first is in progress.
+	for i := 1; i < refreshes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Refresh(context.Background())
+		}()
+	}
+	time.Sleep(time.Millisecond * 50)
+	close(release)
+	wg.Wait()
+	if calls := atomic.LoadInt64(&calls); calls != 1 {
+		t.Errorf("expected concurrent refreshes to be coalesced into 1 call, got %d", calls)
+	}
+}
+
+func TestSecretSnapshot(t *testing.T) {
+	s := New("secret_ARN", WithCacheFor(time.Minute))
+	if snapshot := s.Snapshot(); snapshot != (Snapshot{}) {
+		t.Errorf("expected an empty snapshot before the secret is retrieved, got %+v", snapshot)
+	}
+	if _, ok := s.Cached(); ok {
+		t.Error("expected no cached value before the secret is retrieved")
+	}
+	created := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	s.retrieve = func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		return sm.Value{SecretString: "expected_secret", VersionID: "version_id", CreatedDate: created}, nil
+	}
+	before := time.Now().UTC()
+	if _, err := s.Get(false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cached, ok := s.Cached(); !ok || cached != "expected_secret" {
+		t.Errorf("expected the cached value, got %q, %v", cached, ok)
+	}
+	snapshot := s.Snapshot()
+	if snapshot.Value != "expected_secret" || snapshot.VersionID != "version_id" || !snapshot.CreatedDate.Equal(created) {
+		t.Errorf("expected the value, version ID and created date, got %+v", snapshot)
+	}
+	if snapshot.FetchedAt.Before(before) || snapshot.FetchedAt.After(time.Now().UTC()) {
+		t.Errorf("expected FetchedAt to be the time of the call, got %v", snapshot.FetchedAt)
+	}
+	if !snapshot.ExpiresAt.Equal(snapshot.FetchedAt.Add(time.Minute)) {
+		t.Errorf("expected the snapshot to expire after a minute, got %v", snapshot.ExpiresAt)
+	}
+}
+
+func TestSecretReadersAreNotBlockedByRefresh(t *testing.T) {
+	s := New("secret_ARN", WithCacheFor(time.Minute), WithMaxStaleness(time.Hour), WithMinForceInterval(0))
+	s.update(sm.Value{SecretString: "cached_secret"}, time.Now().UTC())
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		close(started)
+		<-release
+		return "new_secret", nil
+	})
+	refreshed := make(chan error)
+	go func() {
+		_, _, err := s.Refresh(context.Background())
+		refreshed <- err
+	}()
+	<-started
+	// The cached value is served while the refresh is in progress.
+	if secret, err := s.Get(false); err != nil || secret != "cached_secret" {
+		t.Errorf("expected the cached secret, got %q, %v", secret, err)
+	}
+	// Expired values are served too, rather than waiting for the refresh.
+	s.current.Store(&Snapshot{Value: "expired_secret", ExpiresAt: time.Now().UTC().Add(-time.Minute)})
+	if secret, err := s.Get(false); err != nil || secret != "expired_secret" {
+		t.Errorf("expected the expired secret, got %q, %v", secret, err)
+	}
+	close(release)
+	if err := <-refreshed; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secret := s.Snapshot().Value; secret != "new_secret" {
+		t.Errorf("expected the refreshed secret, got %q", secret)
+	}
+}
+
+func TestSecretConcurrentUse(t *testing.T) {
+	var calls int64
+	s := New("secret_ARN", WithCacheFor(time.Millisecond))
+	s.retrieve = func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		n := atomic.AddInt64(&calls, 1)
+		v.SecretString = fmt.Sprintf("secret_%d", n)
+		v.VersionID = fmt.Sprintf("version_%d", n)
+		return
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				v, err := s.GetValue(context.Background(), j%10 == i)
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+				if strings.TrimPrefix(v.SecretString, "secret_") != strings.TrimPrefix(v.VersionID, "version_") {
+					t.Errorf("expected the value and version ID to match, got %+v", v)
+				}
+				snapshot := s.Snapshot()
+				if strings.TrimPrefix(snapshot.Value, "secret_") != strings.TrimPrefix(snapshot.VersionID, "version_") {
+					t.Errorf("expected the snapshot's value and version ID to match, got %+v", snapshot)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+// retrieveString adapts a function which returns the secret string to a sm.RetrieveFunc.
+func retrieveString(f func(ctx context.Context, arn, stage string) (secret string, err error)) sm.RetrieveFunc {
+	return func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		v.SecretString, err = f(ctx, arn, stage)
+		return
+	}
+}
@@ -1,31 +1,61 @@
 package sm
 
 import (
+	"context"
 	"strings"
+	"time"
+)
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/secretsmanager"
+// Secrets Manager staging labels.
+const (
+	// StageCurrent is the current version of the secret.
+	StageCurrent = "AWSCURRENT"
+	// StagePrevious is the version of the secret that was current before the last rotation.
+	StagePrevious = "AWSPREVIOUS"
+	// StagePending is the version of the secret being created by an in-progress rotation.
+	StagePending = "AWSPENDING"
 )
 
+// Value is a version of a secret.
+type Value struct {
+	SecretString string
+	VersionID    string
+	// CreatedDate is when the version was created, e.g. by a rotation. It's zero if the
+	// retriever doesn't provide it.
+	CreatedDate time.Time
+}
+
+// RetrieveFunc retrieves the version of the secret with the given staging label.
+type RetrieveFunc func(ctx context.Context, name, stage string) (v Value, err error)
+
 // DefaultRetrieve retrieves data from AWS Secrets Manager.
 func DefaultRetrieve(name string) (secret string, err error) {
-	cfg := aws.NewConfig()
-	if region, ok := getRegionFromARN(name); ok {
-		cfg = cfg.WithRegion(region)
-	}
-	svc := secretsmanager.New(session.New(cfg))
-	input := &secretsmanager.GetSecretValueInput{
-		SecretId:     aws.String(name),
-		VersionStage: aws.String("AWSCURRENT"),
-	}
-	var result *secretsmanager.GetSecretValueOutput
-	result, err = svc.GetSecretValue(input)
+	return DefaultRetrieveContext(context.Background(), name)
+}
+
+// DefaultRetrieveContext retrieves data from AWS Secrets Manager, abandoning the request
+// if the context is cancelled or its deadline is exceeded.
+func DefaultRetrieveContext(ctx context.Context, name string) (secret string, err error) {
+	return DefaultRetrieveStage(ctx, name, StageCurrent)
+}
+
+// DefaultRetrieveStage retrieves the version of the secret with the given staging label
+// from AWS Secrets Manager.
+func DefaultRetrieveStage(ctx context.Context, name, stage string) (secret string, err error) {
+	v, err := DefaultRetrieveValue(ctx, name, stage)
+	return v.SecretString, err
+}
+
+// DefaultRetrieveValue retrieves the version of the secret with the given staging label,
+// and its version ID, from AWS Secrets Manager. The region is taken from the secret's ARN,
+// and a client is created once per region.
+func DefaultRetrieveValue(ctx context.Context, name, stage string) (v Value, err error) {
+	region, _ := getRegionFromARN(name)
+	r, err := defaultRetriever(region)
 	if err != nil {
 		return
 	}
-	secret = *result.SecretString
-	return
+	return r.RetrieveValue(ctx, name, stage)
 }
 
 func getRegionFromARN(arn string) (region string, ok bool) {
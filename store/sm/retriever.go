@@ -0,0 +1,91 @@
+package sm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+// Retriever retrieves secrets from AWS Secrets Manager, reusing a single client.
+type Retriever struct {
+	client secretsmanageriface.SecretsManagerAPI
+}
+
+// NewRetriever creates a Retriever which uses the given Secrets Manager client. The client
+// should disable the SDK's retries, e.g. with aws.Config.WithMaxRetries(0), because failed
+// calls are retried by WithRetry.
+func NewRetriever(client secretsmanageriface.SecretsManagerAPI) *Retriever {
+	return &Retriever{
+		client: client,
+	}
+}
+
+// NewRetrieverFromConfig creates a Retriever with a client created from the configuration,
+// e.g. to set the region, endpoint, credentials or HTTP client. The SDK's retries are
+// disabled unless the configuration sets MaxRetries, because failed calls are retried by
+// WithRetry, and retrying in both multiplies the calls made while throttled.
+func NewRetrieverFromConfig(cfgs ...*aws.Config) (r *Retriever, err error) {
+	cfgs = append([]*aws.Config{aws.NewConfig().WithMaxRetries(0)}, cfgs...)
+	sess, err := session.NewSession(cfgs...)
+	if err != nil {
+		return
+	}
+	r = NewRetriever(secretsmanager.New(sess))
+	return
+}
+
+// Retrieve the version of the secret with the given staging label.
+func (r *Retriever) Retrieve(ctx context.Context, name, stage string) (secret string, err error) {
+	v, err := r.RetrieveValue(ctx, name, stage)
+	return v.SecretString, err
+}
+
+// RetrieveValue retrieves the version of the secret with the given staging label, and
+// its version ID.
+func (r *Retriever) RetrieveValue(ctx context.Context, name, stage string) (v Value, err error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(name),
+		VersionStage: aws.String(stage),
+	}
+	var result *secretsmanager.GetSecretValueOutput
+	result, err = r.client.GetSecretValueWithContext(ctx, input)
+	if err != nil {
+		return
+	}
+	v = Value{
+		SecretString: aws.StringValue(result.SecretString),
+		VersionID:    aws.StringValue(result.VersionId),
+		CreatedDate:  aws.TimeValue(result.CreatedDate),
+	}
+	return
+}
+
+// defaultRetrievers are created on demand by DefaultRetrieveValue, one per region.
+var defaultRetrievers = struct {
+	m        sync.Mutex
+	byRegion map[string]*Retriever
+}{
+	byRegion: make(map[string]*Retriever),
+}
+
+func defaultRetriever(region string) (r *Retriever, err error) {
+	defaultRetrievers.m.Lock()
+	defer defaultRetrievers.m.Unlock()
+	if r, ok := defaultRetrievers.byRegion[region]; ok {
+		return r, nil
+	}
+	cfg := aws.NewConfig()
+	if region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	r, err = NewRetrieverFromConfig(cfg)
+	if err != nil {
+		return
+	}
+	defaultRetrievers.byRegion[region] = r
+	return
+}
@@ -0,0 +1,104 @@
+package sm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+func TestRetriever(t *testing.T) {
+	created := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	client := &mockClient{
+		output: &secretsmanager.GetSecretValueOutput{
+			SecretString: aws.String("expected_secret"),
+			VersionId:    aws.String("version_id"),
+			CreatedDate:  aws.Time(created),
+		},
+	}
+	r := NewRetriever(client)
+	v, err := r.RetrieveValue(context.Background(), "secret_ARN", StagePrevious)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Value{SecretString: "expected_secret", VersionID: "version_id", CreatedDate: created}
+	if v != expected {
+		t.Errorf("expected %+v, got %+v", expected, v)
+	}
+	if id := aws.StringValue(client.input.SecretId); id != "secret_ARN" {
+		t.Errorf("expected secret ID 'secret_ARN', got '%v'", id)
+	}
+	if stage := aws.StringValue(client.input.VersionStage); stage != StagePrevious {
+		t.Errorf("expected version stage '%v', got '%v'", StagePrevious, stage)
+	}
+}
+
+func TestRetrieverErrors(t *testing.T) {
+	expectedErr := errors.New("failure")
+	r := NewRetriever(&mockClient{
+		err: expectedErr,
+	})
+	_, err := r.Retrieve(context.Background(), "secret_ARN", StageCurrent)
+	if err != expectedErr {
+		t.Errorf("expected error %v, got %v", expectedErr, err)
+	}
+}
+
+func TestDefaultRetrieversAreReused(t *testing.T) {
+	a, err := defaultRetriever("eu-west-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := defaultRetriever("eu-west-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a != b {
+		t.Error("expected the retriever to be reused")
+	}
+}
+
+type mockClient struct {
+	secretsmanageriface.SecretsManagerAPI
+	input  *secretsmanager.GetSecretValueInput
+	output *secretsmanager.GetSecretValueOutput
+	err    error
+}
+
+func (mc *mockClient) GetSecretValueWithContext(ctx aws.Context, input *secretsmanager.GetSecretValueInput, opts ...request.Option) (*secretsmanager.GetSecretValueOutput, error) {
+	mc.input = input
+	return mc.output, mc.err
+}
+
+func TestRetrieverFromConfigDoesNotRetry(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type":"ThrottlingException","message":"Rate exceeded"}`))
+	}))
+	defer server.Close()
+	r, err := NewRetrieverFromConfig(aws.NewConfig().
+		WithRegion("eu-west-2").
+		WithEndpoint(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("id", "secret", "")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err = r.RetrieveValue(context.Background(), "secret_ARN", StageCurrent); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := atomic.LoadInt64(&requests); n != 1 {
+		t.Errorf("expected the SDK not to retry, got %d requests", n)
+	}
+}
@@ -0,0 +1,71 @@
+package sm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// GetSecretValueAPI is the part of the aws-sdk-go-v2 Secrets Manager client used by
+// V2Retriever.
+type GetSecretValueAPI interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// V2Retriever retrieves secrets from AWS Secrets Manager using aws-sdk-go-v2, reusing a
+// single client.
+type V2Retriever struct {
+	client GetSecretValueAPI
+}
+
+// NewV2Retriever creates a V2Retriever which uses the given Secrets Manager client.
+func NewV2Retriever(client GetSecretValueAPI) *V2Retriever {
+	return &V2Retriever{
+		client: client,
+	}
+}
+
+// NewV2RetrieverFromConfig creates a V2Retriever with a client created from the
+// configuration, e.g. as loaded by config.LoadDefaultConfig. The client's retries are
+// disabled, because failed calls are retried by WithRetry, and retrying in both multiplies
+// the calls made while throttled. Clients passed to NewV2Retriever should also disable
+// retries, e.g. by setting the Retryer option to aws.NopRetryer.
+func NewV2RetrieverFromConfig(cfg aws.Config) *V2Retriever {
+	return NewV2Retriever(secretsmanager.NewFromConfig(cfg, func(o *secretsmanager.Options) {
+		o.Retryer = aws.NopRetryer{}
+	}))
+}
+
+// Retrieve the version of the secret with the given staging label. See RetrieveValue.
+func (r *V2Retriever) Retrieve(ctx context.Context, name, stage string) (secret string, err error) {
+	v, err := r.RetrieveValue(ctx, name, stage)
+	return v.SecretString, err
+}
+
+// RetrieveValue retrieves the version of the secret with the given staging label, and its
+// version ID. If the name is an ARN, the request is sent to the secret's region,
+// regardless of the configured region.
+func (r *V2Retriever) RetrieveValue(ctx context.Context, name, stage string) (v Value, err error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(name),
+		VersionStage: aws.String(stage),
+	}
+	var optFns []func(*secretsmanager.Options)
+	if region, ok := getRegionFromARN(name); ok {
+		optFns = append(optFns, func(o *secretsmanager.Options) {
+			o.Region = region
+		})
+	}
+	var result *secretsmanager.GetSecretValueOutput
+	result, err = r.client.GetSecretValue(ctx, input, optFns...)
+	if err != nil {
+		return
+	}
+	v = Value{
+		SecretString: aws.ToString(result.SecretString),
+		VersionID:    aws.ToString(result.VersionId),
+		CreatedDate:  aws.ToTime(result.CreatedDate),
+	}
+	return
+}
@@ -0,0 +1,122 @@
+package sm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+func TestV2Retriever(t *testing.T) {
+	created := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		secretID       string
+		expectedRegion string
+	}{
+		{
+			name:           "the region is taken from the ARN",
+			secretID:       "arn:aws:secretsmanager:eu-west-2:123456789012:secret:db-AbCdEf",
+			expectedRegion: "eu-west-2",
+		},
+		{
+			name:           "the configured region is used for secret names",
+			secretID:       "db",
+			expectedRegion: "us-east-1",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			client := &mockV2Client{
+				region: "us-east-1",
+				output: &secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("expected_secret"),
+					CreatedDate:  aws.Time(created),
+				},
+			}
+			r := NewV2Retriever(client)
+			v, err := r.RetrieveValue(context.Background(), test.secretID, StagePending)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v.SecretString != "expected_secret" {
+				t.Errorf("expected secret 'expected_secret', got '%v'", v.SecretString)
+			}
+			if !v.CreatedDate.Equal(created) {
+				t.Errorf("expected created date %v, got %v", created, v.CreatedDate)
+			}
+			if id := aws.ToString(client.input.SecretId); id != test.secretID {
+				t.Errorf("expected secret ID '%v', got '%v'", test.secretID, id)
+			}
+			if stage := aws.ToString(client.input.VersionStage); stage != StagePending {
+				t.Errorf("expected version stage '%v', got '%v'", StagePending, stage)
+			}
+			if client.requestRegion != test.expectedRegion {
+				t.Errorf("expected region '%v', got '%v'", test.expectedRegion, client.requestRegion)
+			}
+		})
+	}
+}
+
+func TestV2RetrieverErrors(t *testing.T) {
+	expectedErr := errors.New("failure")
+	r := NewV2Retriever(&mockV2Client{
+		err: expectedErr,
+	})
+	_, err := r.Retrieve(context.Background(), "secret_ARN", StageCurrent)
+	if err != expectedErr {
+		t.Errorf("expected error %v, got %v", expectedErr, err)
+	}
+}
+
+type mockV2Client struct {
+	region        string
+	requestRegion string
+	input         *secretsmanager.GetSecretValueInput
+	output        *secretsmanager.GetSecretValueOutput
+	err           error
+}
+
+func (mc *mockV2Client) GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	o := secretsmanager.Options{
+		Region: mc.region,
+	}
+	for _, f := range optFns {
+		f(&o)
+	}
+	mc.input = params
+	mc.requestRegion = o.Region
+	return mc.output, mc.err
+}
+
+func TestV2RetrieverFromConfigDoesNotRetry(t *testing.T) {
+	var requests int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&requests, 1)
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type":"ThrottlingException","message":"Rate exceeded"}`))
+	}))
+	defer server.Close()
+	r := NewV2RetrieverFromConfig(aws.Config{
+		Region:       "eu-west-2",
+		BaseEndpoint: aws.String(server.URL),
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "id", SecretAccessKey: "secret"}, nil
+		}),
+	})
+	if _, err := r.RetrieveValue(context.Background(), "secret_ARN", StageCurrent); err == nil {
+		t.Fatal("expected an error")
+	}
+	if n := atomic.LoadInt64(&requests); n != 1 {
+		t.Errorf("expected the SDK not to retry, got %d requests", n)
+	}
+}
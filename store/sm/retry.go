@@ -0,0 +1,128 @@
+package sm
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/smithy-go"
+)
+
+// RetryPolicy determines how failed calls to Secrets Manager are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls made, including the first.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, which doubles on each subsequent
+	// retry, up to MaxDelay. The actual delay is a random duration up to this value.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay between retries.
+	MaxDelay time.Duration
+	// IsRetryable determines whether an error is transient. Defaults to IsRetryable.
+	IsRetryable func(err error) bool
+	// OnRetry, if set, is called before waiting to retry a failed attempt, e.g. to record
+	// metrics. attempt is 1 for the first retry.
+	OnRetry func(name, stage string, attempt int, delay time.Duration, err error)
+}
+
+// DefaultRetryPolicy makes up to 3 attempts, waiting up to 100ms, then 200ms.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Millisecond * 100,
+	MaxDelay:    time.Second * 5,
+	IsRetryable: IsRetryable,
+}
+
+// NoRetry makes a single attempt.
+var NoRetry = RetryPolicy{
+	MaxAttempts: 1,
+}
+
+// WithRetry wraps the retrieve function, retrying transient errors according to the
+// policy. Waiting between attempts is abandoned if the context is cancelled.
+func WithRetry(p RetryPolicy, retrieve RetrieveFunc) RetrieveFunc {
+	isRetryable := p.IsRetryable
+	if isRetryable == nil {
+		isRetryable = IsRetryable
+	}
+	return func(ctx context.Context, name, stage string) (v Value, err error) {
+		for attempt := 0; ; attempt++ {
+			v, err = retrieve(ctx, name, stage)
+			if err == nil || attempt+1 >= p.MaxAttempts || ctx.Err() != nil || !isRetryable(err) {
+				return
+			}
+			delay := p.delay(attempt)
+			if p.OnRetry != nil {
+				p.OnRetry(name, stage, attempt+1, delay, err)
+			}
+			t := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+		}
+	}
+}
+
+// delay returns a random duration up to the exponential backoff for the attempt.
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	backoff := p.BaseDelay
+	for i := 0; i < attempt && (p.MaxDelay <= 0 || backoff < p.MaxDelay); i++ {
+		backoff *= 2
+	}
+	if p.MaxDelay > 0 && backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(backoff)))
+}
+
+// IsRetryable returns true if the error is a throttling error, an internal service error,
+// a 5xx response, or a network error or timeout.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var rf awserr.RequestFailure
+	if errors.As(err, &rf) && (rf.StatusCode() >= http.StatusInternalServerError || rf.StatusCode() == http.StatusTooManyRequests) {
+		return true
+	}
+	var ae awserr.Error
+	if errors.As(err, &ae) && isRetryableCode(ae.Code()) {
+		return true
+	}
+	// aws-sdk-go-v2 errors.
+	var hse interface{ HTTPStatusCode() int }
+	if errors.As(err, &hse) && (hse.HTTPStatusCode() >= http.StatusInternalServerError || hse.HTTPStatusCode() == http.StatusTooManyRequests) {
+		return true
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && isRetryableCode(apiErr.ErrorCode()) {
+		return true
+	}
+	var ne net.Error
+	return errors.As(err, &ne) && ne.Timeout()
+}
+
+func isRetryableCode(code string) bool {
+	switch code {
+	case "ThrottlingException",
+		"Throttling",
+		"TooManyRequestsException",
+		secretsmanager.ErrCodeInternalServiceError,
+		request.ErrCodeRequestError,
+		request.ErrCodeResponseTimeout,
+		request.ErrCodeRead:
+		return true
+	}
+	return false
+}
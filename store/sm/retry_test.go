@@ -0,0 +1,176 @@
+package sm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/smithy-go"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "throttling",
+			err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			expected: true,
+		},
+		{
+			name:     "internal service error",
+			err:      awserr.New(secretsmanager.ErrCodeInternalServiceError, "", nil),
+			expected: true,
+		},
+		{
+			name:     "5xx responses",
+			err:      awserr.NewRequestFailure(awserr.New("Unknown", "", nil), http.StatusBadGateway, "request_id"),
+			expected: true,
+		},
+		{
+			name:     "aws-sdk-go-v2 throttling",
+			err:      &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"},
+			expected: true,
+		},
+		{
+			name:     "missing secrets",
+			err:      awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil),
+			expected: false,
+		},
+		{
+			name:     "4xx responses",
+			err:      awserr.NewRequestFailure(awserr.New("AccessDeniedException", "", nil), http.StatusForbidden, "request_id"),
+			expected: false,
+		},
+		{
+			name:     "context cancellation",
+			err:      context.Canceled,
+			expected: false,
+		},
+		{
+			name:     "other errors",
+			err:      errors.New("failure"),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if actual := IsRetryable(test.err); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	throttled := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	notFound := awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil)
+	tests := []struct {
+		name          string
+		errs          []error
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "successful calls are not retried",
+			errs:          []error{nil},
+			expectedCalls: 1,
+		},
+		{
+			name:          "transient errors are retried",
+			errs:          []error{throttled, throttled, nil},
+			expectedCalls: 3,
+		},
+		{
+			name:          "retries stop after the maximum number of attempts",
+			errs:          []error{throttled, throttled, throttled, nil},
+			expectedCalls: 3,
+			expectedErr:   throttled,
+		},
+		{
+			name:          "other errors are not retried",
+			errs:          []error{notFound, nil},
+			expectedCalls: 1,
+			expectedErr:   notFound,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int
+			retrieve := WithRetry(RetryPolicy{
+				MaxAttempts: 3,
+				BaseDelay:   time.Millisecond,
+				MaxDelay:    time.Millisecond * 5,
+			}, func(ctx context.Context, name, stage string) (v Value, err error) {
+				err = test.errs[calls]
+				calls++
+				return
+			})
+			_, err := retrieve(context.Background(), "secret_ARN", StageCurrent)
+			if err != test.expectedErr {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestWithRetryStopsWhenTheContextIsCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	retrieve := WithRetry(RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelay:   time.Hour,
+	}, func(ctx context.Context, name, stage string) (v Value, err error) {
+		calls++
+		cancel()
+		return Value{}, awserr.New("ThrottlingException", "Rate exceeded", nil)
+	})
+	_, err := retrieve(ctx, "secret_ARN", StageCurrent)
+	if err == nil {
+		t.Error("expected an error")
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestWithRetryCallsOnRetry(t *testing.T) {
+	throttled := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	var attempts []int
+	var calls int
+	retrieve := WithRetry(RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		OnRetry: func(name, stage string, attempt int, delay time.Duration, err error) {
+			if name != "secret_ARN" || stage != StageCurrent || err != throttled {
+				t.Errorf("unexpected retry of %s (%s): %v", name, stage, err)
+			}
+			attempts = append(attempts, attempt)
+		},
+	}, func(ctx context.Context, name, stage string) (v Value, err error) {
+		calls++
+		if calls < 3 {
+			err = throttled
+		}
+		return
+	})
+	if _, err := retrieve(context.Background(), "secret_ARN", StageCurrent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Errorf("expected retries 1 and 2, got %v", attempts)
+	}
+}
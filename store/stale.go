@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// LastError returns the error from the most recent attempt to retrieve the secret, or nil
+// if it succeeded.
+func (s *Secret) LastError() error {
+	s.errM.Lock()
+	defer s.errM.Unlock()
+	return s.lastErr
+}
+
+// setLastError records the result of retrieving the secret. Errors caused by the caller's
+// context aren't recorded, so that they aren't returned to other callers.
+func (s *Secret) setLastError(ctx context.Context, err error) {
+	if err != nil && isContextError(ctx, err) {
+		return
+	}
+	s.errM.Lock()
+	defer s.errM.Unlock()
+	s.lastErr = err
+	if err != nil {
+		s.lastErrAt = time.Now().UTC()
+	}
+}
+
+// isContextError returns true if the error was caused by the caller's context being
+// cancelled or expiring, rather than by Secrets Manager.
+func isContextError(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// recentError returns the last error if it occurred within the error backoff period.
+func (s *Secret) recentError(now time.Time) error {
+	s.errM.Lock()
+	defer s.errM.Unlock()
+	if s.lastErr == nil || now.After(s.lastErrAt.Add(s.errorBackoff)) {
+		return nil
+	}
+	return s.lastErr
+}
+
+// canServeStale returns true if there's a cached value which expired less than the max
+// staleness ago.
+func (s *Secret) canServeStale(snapshot *Snapshot, now time.Time) bool {
+	if snapshot == nil {
+		return false
+	}
+	return !now.After(snapshot.ExpiresAt.Add(s.maxStaleness))
+}
@@ -0,0 +1,145 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+)
+
+func TestStaleWhileError(t *testing.T) {
+	retrievalError := errors.New("retrieval error")
+	tests := []struct {
+		name           string
+		lastRefreshed  time.Duration
+		previousErr    bool
+		force          bool
+		expectedSecret string
+		expectedErr    error
+		expectedCalls  int
+	}{
+		{
+			name:           "expired values within the max staleness are returned on error",
+			lastRefreshed:  -(time.Hour + time.Minute),
+			expectedSecret: "stale_secret",
+			expectedCalls:  1,
+		},
+		{
+			name:          "expired values beyond the max staleness are not returned",
+			lastRefreshed: -(time.Hour*3 + time.Minute),
+			expectedErr:   retrievalError,
+			expectedCalls: 1,
+		},
+		{
+			name:          "forced refreshes return the error",
+			lastRefreshed: -(time.Hour + time.Minute),
+			force:         true,
+			expectedErr:   retrievalError,
+			expectedCalls: 1,
+		},
+		{
+			name:           "during the error backoff, the stale value is returned without a call",
+			lastRefreshed:  -(time.Hour + time.Minute),
+			previousErr:    true,
+			expectedSecret: "stale_secret",
+			expectedCalls:  0,
+		},
+		{
+			name:          "during the error backoff, the error is returned without a call if there's no stale value",
+			lastRefreshed: -(time.Hour*3 + time.Minute),
+			previousErr:   true,
+			expectedErr:   retrievalError,
+			expectedCalls: 0,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int
+			s := New("secret_ARN",
+				WithCacheFor(time.Hour),
+				WithMaxStaleness(time.Hour),
+				WithErrorBackoff(time.Minute))
+			s.update(sm.Value{SecretString: "stale_secret"}, time.Now().UTC().Add(test.lastRefreshed))
+			if test.previousErr {
+				s.setLastError(context.Background(), retrievalError)
+			}
+			s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+				calls++
+				return "", retrievalError
+			})
+			secret, err := s.Get(test.force)
+			if err != test.expectedErr {
+				t.Errorf("expected err: %v, got: %v", test.expectedErr, err)
+			}
+			if secret != test.expectedSecret {
+				t.Errorf("expected secret '%v', got '%v'", test.expectedSecret, secret)
+			}
+			if calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, calls)
+			}
+			if s.LastError() != retrievalError {
+				t.Errorf("expected the last error to be recorded, got: %v", s.LastError())
+			}
+		})
+	}
+}
+
+func TestLastErrorIsClearedOnSuccess(t *testing.T) {
+	s := New("secret_ARN")
+	s.setLastError(context.Background(), errors.New("retrieval error"))
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		return "expected_secret", nil
+	})
+	if _, err := s.Get(true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.LastError() != nil {
+		t.Errorf("expected the last error to be cleared, got: %v", s.LastError())
+	}
+}
+
+func TestContextErrorsAreNotRecorded(t *testing.T) {
+	s := New("secret_ARN")
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		if _, ok := ctx.Deadline(); ok {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}
+		return "expected_secret", nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*5)
+	defer cancel()
+	if _, err := s.GetContext(ctx, false); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the context error, got: %v", err)
+	}
+	if s.LastError() != nil {
+		t.Errorf("expected the context error not to be recorded, got: %v", s.LastError())
+	}
+	// Other callers aren't given the first caller's error.
+	secret, err := s.GetContext(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if secret != "expected_secret" {
+		t.Errorf("expected 'expected_secret', got '%v'", secret)
+	}
+}
+
+func TestCancelledBackgroundRefreshIsNotRecorded(t *testing.T) {
+	s := New("secret_ARN")
+	s.retrieve = retrieveString(func(ctx context.Context, arn, stage string) (secret string, err error) {
+		return "", ctx.Err()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.refreshInBackground(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the context error, got: %v", err)
+	}
+	if s.LastError() != nil {
+		t.Errorf("expected the context error not to be recorded, got: %v", s.LastError())
+	}
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"net/url"
+	"text/template"
+)
+
+// FieldMapping maps the fields of the credentials to JSON pointers (RFC 6901) within the
+// secret, for secrets which don't use the RDS layout, e.g. "/user", "/db/pass", or
+// "/accounts/1/user" for a secret which holds several accounts. Empty fields use the RDS
+// layout, e.g. "/username".
+type FieldMapping struct {
+	Username string
+	Password string
+	Host     string
+	Port     string
+	DBName   string
+	Engine   string
+}
+
+func (m FieldMapping) withDefaults(hostKey string) FieldMapping {
+	defaults := FieldMapping{
+		Username: "/username",
+		Password: "/password",
+		Host:     "/" + escapePointerToken(hostKey),
+		Port:     "/port",
+		DBName:   "/dbname",
+		Engine:   "/engine",
+	}
+	for _, f := range []struct{ v, d *string }{
+		{&m.Username, &defaults.Username},
+		{&m.Password, &defaults.Password},
+		{&m.Host, &defaults.Host},
+		{&m.Port, &defaults.Port},
+		{&m.DBName, &defaults.DBName},
+		{&m.Engine, &defaults.Engine},
+	} {
+		if *f.v == "" {
+			*f.v = *f.d
+		}
+	}
+	return m
+}
+
+// TemplateData is passed to templates created with NewDSNTemplate.
+type TemplateData struct {
+	Credentials
+	// Params passed to NewRDS.
+	Params map[string]string
+	// Secret is the decoded secret JSON, for use with the pointer function.
+	Secret interface{}
+}
+
+// NewDSNTemplate parses a text/template which renders the connection string from
+// TemplateData, for use with WithDSNTemplate. In addition to the standard functions,
+// templates can use:
+//
+//	pointer .Secret "/path"   the value at the JSON pointer within the secret
+//	queryEscape .Password     escape a value for use in a URL query
+//	pathEscape .Password      escape a value for use in a URL path, or user info
+//
+// For example:
+//
+//	{{ .Username }}:{{ .Password }}@tcp({{ .Host }}:{{ .Port }})/{{ pointer .Secret "/schema" }}
+func NewDSNTemplate(text string) (*template.Template, error) {
+	return template.New("dsn").Funcs(template.FuncMap{
+		"pointer":     templatePointer,
+		"queryEscape": url.QueryEscape,
+		"pathEscape":  url.PathEscape,
+	}).Parse(text)
+}
+
+func templatePointer(doc interface{}, pointer string) (v interface{}, err error) {
+	v, _, err = lookup(doc, pointer)
+	return
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRDSDSNTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		secret      string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "credentials and params are available",
+			template: `{{ .Username }}:{{ .Password }}@tcp({{ .Addr }})/{{ .DBName }}?timeout={{ index .Params "connect_timeout" }}`,
+			secret:   `{ "username": "user", "password": "pwd", "host": "host_name", "port": 3306 }`,
+			expected: "user:pwd@tcp(host_name:3306)/databaseName?timeout=10",
+		},
+		{
+			name:     "other fields of the secret can be read with pointer",
+			template: `{{ .Username }}@{{ .Host }}/{{ pointer .Secret "/options/schema" }}`,
+			secret:   `{ "username": "user", "password": "pwd", "host": "host_name", "options": { "schema": "app" } }`,
+			expected: "user@host_name/app",
+		},
+		{
+			name:     "values can be escaped",
+			template: `db://{{ pathEscape .Username }}:{{ pathEscape .Password }}@{{ .Host }}?p={{ queryEscape .Password }}`,
+			secret:   `{ "username": "user", "password": "p@ss/word&", "host": "host_name" }`,
+			expected: "db://user:p@ss%2Fword&@host_name?p=p%40ss%2Fword%26",
+		},
+		{
+			name:        "template errors are returned",
+			template:    `{{ pointer .Secret "invalid" }}`,
+			secret:      `{ "username": "user", "password": "pwd", "host": "host_name" }`,
+			expectedErr: errors.New(`store: could not render DSN template: template: dsn:1:3: executing "dsn" at <pointer .Secret "invalid">: error calling pointer: store: invalid JSON pointer "invalid"`),
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			tmpl, err := NewDSNTemplate(test.template)
+			if err != nil {
+				t.Fatalf("unexpected error parsing template: %v", err)
+			}
+			s, err := NewRDS("secret_ARN", "databaseName", map[string]string{"connect_timeout": "10"}, WithDSNTemplate(tmpl))
+			if err != nil {
+				t.Fatalf("unexpected error creating RDS: %v", err)
+			}
+			s.child = &mockSecret{
+				GetResults: []SecretGetResult{
+					{
+						Credential: test.secret,
+					},
+				},
+			}
+			dsn, err := s.Get(false)
+			if !errorsEqual(err, test.expectedErr) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if dsn != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, dsn)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/certs"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+type tlsOptions struct {
+	// secretARN is used to choose the CA bundle for the secret's region.
+	secretARN  string
+	caBundle   []byte
+	serverName string
+	minVersion uint16
+	skipVerify bool
+	disabled   bool
+	// verifyConnection warns when the server's CA is near expiry.
+	verifyConnection func(cs tls.ConnectionState) error
+}
+
+// tlsConfigs is used to give each store's MySQL TLS configuration a unique name.
+var tlsConfigs uint64
+
+// caPEM returns the custom CA bundle, or the RDS certificates for the secret's region.
+func (o tlsOptions) caPEM() (pem []byte, err error) {
+	if o.caBundle != nil {
+		return o.caBundle, nil
+	}
+	pem, err = certs.ForARN(o.secretARN)
+	if err != nil {
+		err = fmt.Errorf("store: could not load certificates: %v", err)
+	}
+	return
+}
+
+// registerTLSConfig registers the store's TLS configuration with the MySQL driver under a
+// unique name, so that stores with different requirements don't overwrite each other.
+func registerTLSConfig(o tlsOptions) (name string, config *tls.Config, err error) {
+	if o.disabled {
+		return
+	}
+	config = &tls.Config{
+		ServerName:         o.serverName,
+		MinVersion:         o.minVersion,
+		InsecureSkipVerify: o.skipVerify,
+		VerifyConnection:   o.verifyConnection,
+	}
+	if !o.skipVerify {
+		var pem []byte
+		pem, err = o.caPEM()
+		if err != nil {
+			return
+		}
+		config.RootCAs = x509.NewCertPool()
+		if ok := config.RootCAs.AppendCertsFromPEM(pem); !ok {
+			err = errors.New("store: could not append certificates from PEM")
+			return
+		}
+	}
+	name = fmt.Sprintf("rds-%d", atomic.AddUint64(&tlsConfigs, 1))
+	if err = mysql.RegisterTLSConfig(name, config); err != nil {
+		err = fmt.Errorf("store: could not register TLS config: %v", err)
+	}
+	return
+}
+
+// tlsVersions maps TLS versions to the values used in connection strings.
+var tlsVersions = map[uint16]string{
+	tls.VersionTLS10: "1.0",
+	tls.VersionTLS11: "1.1",
+	tls.VersionTLS12: "1.2",
+	tls.VersionTLS13: "1.3",
+}
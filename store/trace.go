@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const instrumentationName = "github.com/a-h/go-sql-driver-rds-credentials/store"
+
+// Span attributes. The value of the secret is never recorded.
+const (
+	attrSecretID   = attribute.Key("secretsmanager.secret_id")
+	attrStage      = attribute.Key("secretsmanager.stage")
+	attrVersionID  = attribute.Key("secretsmanager.version_id")
+	attrForced     = attribute.Key("store.forced")
+	attrCacheHit   = attribute.Key("store.cache_hit")
+	attrStale      = attribute.Key("store.stale")
+	attrBackground = attribute.Key("store.background")
+)
+
+// WithTracerProvider records OpenTelemetry spans for getting the secret ("store.Get" and
+// "store.GetStage"), retrieving it including any retries ("store.Fetch"), and each call to
+// Secrets Manager ("secretsmanager.GetSecretValue"). Spans are children of the span in the
+// context passed to the store. By default, no spans are recorded.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracer = tp.Tracer(instrumentationName)
+	}
+}
+
+func defaultTracer() trace.Tracer {
+	return noop.NewTracerProvider().Tracer(instrumentationName)
+}
+
+// traceRetrieve records a span for each call to f.
+func traceRetrieve(tracer trace.Tracer, f sm.RetrieveFunc) sm.RetrieveFunc {
+	return func(ctx context.Context, name, stage string) (v sm.Value, err error) {
+		ctx, span := tracer.Start(ctx, "secretsmanager.GetSecretValue",
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrSecretID.String(name), attrStage.String(stage)))
+		defer func() {
+			if err == nil {
+				span.SetAttributes(attrVersionID.String(v.VersionID))
+			}
+			endSpan(span, err)
+		}()
+		return f(ctx, name, stage)
+	}
+}
+
+// endSpan ends the span, recording the error if there is one.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,215 @@
+package store
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a-h/go-sql-driver-rds-credentials/store/sm"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// tracedSpan is the subset of a recorded span which is compared in tests.
+type tracedSpan struct {
+	Name       string
+	Parent     string
+	Attributes map[attribute.Key]interface{}
+	Failed     bool
+}
+
+// tracedSpans returns the ended spans, in the order they ended.
+func tracedSpans(exporter *tracetest.InMemoryExporter) (spans []tracedSpan) {
+	stubs := exporter.GetSpans()
+	names := make(map[string]string)
+	for _, s := range stubs {
+		names[s.SpanContext.SpanID().String()] = s.Name
+	}
+	for _, s := range stubs {
+		attrs := make(map[attribute.Key]interface{})
+		for _, kv := range s.Attributes {
+			attrs[kv.Key] = kv.Value.AsInterface()
+		}
+		spans = append(spans, tracedSpan{
+			Name:       s.Name,
+			Parent:     names[s.Parent.SpanID().String()],
+			Attributes: attrs,
+			Failed:     s.Status.Code == codes.Error,
+		})
+	}
+	return
+}
+
+func TestSecretTracing(t *testing.T) {
+	throttled := awserr.New("ThrottlingException", "Rate exceeded", nil)
+	tests := []struct {
+		name     string
+		retrieve []error
+		gets     []bool
+		expected []tracedSpan
+	}{
+		{
+			name: "a cache miss retrieves the secret",
+			gets: []bool{false},
+			expected: []tracedSpan{
+				{
+					Name:   "secretsmanager.GetSecretValue",
+					Parent: "store.Fetch",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrVersionID: "v1",
+					},
+				},
+				{
+					Name:   "store.Fetch",
+					Parent: "store.Get",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrForced: false, attrBackground: false,
+					},
+				},
+				{
+					Name:   "store.Get",
+					Parent: "test",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrForced: false, attrCacheHit: false, attrStale: false,
+					},
+				},
+			},
+		},
+		{
+			name: "a cache hit doesn't retrieve the secret",
+			gets: []bool{false, false},
+			expected: []tracedSpan{
+				{Name: "secretsmanager.GetSecretValue", Parent: "store.Fetch"},
+				{Name: "store.Fetch", Parent: "store.Get"},
+				{Name: "store.Get", Parent: "test"},
+				{
+					Name:   "store.Get",
+					Parent: "test",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrForced: false, attrCacheHit: true, attrStale: false,
+					},
+				},
+			},
+		},
+		{
+			name: "forced refreshes are recorded",
+			gets: []bool{true},
+			expected: []tracedSpan{
+				{Name: "secretsmanager.GetSecretValue", Parent: "store.Fetch"},
+				{
+					Name:   "store.Fetch",
+					Parent: "store.Get",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrForced: true, attrBackground: false,
+					},
+				},
+				{
+					Name:   "store.Get",
+					Parent: "test",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent, attrForced: true, attrCacheHit: false, attrStale: false,
+					},
+				},
+			},
+		},
+		{
+			name:     "each retry is recorded",
+			retrieve: []error{throttled},
+			gets:     []bool{false},
+			expected: []tracedSpan{
+				{
+					Name:   "secretsmanager.GetSecretValue",
+					Parent: "store.Fetch",
+					Attributes: map[attribute.Key]interface{}{
+						attrSecretID: "secret_ARN", attrStage: sm.StageCurrent,
+					},
+					Failed: true,
+				},
+				{Name: "secretsmanager.GetSecretValue", Parent: "store.Fetch"},
+				{Name: "store.Fetch", Parent: "store.Get"},
+				{Name: "store.Get", Parent: "test"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			exporter := tracetest.NewInMemoryExporter()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			var calls int
+			s := New("secret_ARN",
+				WithTracerProvider(tp),
+				WithRetryPolicy(sm.RetryPolicy{MaxAttempts: 2}),
+				WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+					calls++
+					if calls <= len(tt.retrieve) {
+						return v, tt.retrieve[calls-1]
+					}
+					return sm.Value{SecretString: "secret_value", VersionID: "v1"}, nil
+				}))
+
+			ctx, root := tp.Tracer("test").Start(context.Background(), "test")
+			for _, force := range tt.gets {
+				if _, err := s.GetContext(ctx, force); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			root.End()
+
+			actual := tracedSpans(exporter)
+			// The root span is last.
+			actual = actual[:len(actual)-1]
+			if len(actual) != len(tt.expected) {
+				t.Fatalf("expected %d spans, got %d: %+v", len(tt.expected), len(actual), actual)
+			}
+			for i, expected := range tt.expected {
+				if expected.Name != actual[i].Name || expected.Parent != actual[i].Parent || expected.Failed != actual[i].Failed {
+					t.Errorf("span %d: expected %s (parent %s, failed %v), got %s (parent %s, failed %v)", i,
+						expected.Name, expected.Parent, expected.Failed, actual[i].Name, actual[i].Parent, actual[i].Failed)
+				}
+				if expected.Attributes != nil && !reflect.DeepEqual(expected.Attributes, actual[i].Attributes) {
+					t.Errorf("span %d: expected attributes %v, got %v", i, expected.Attributes, actual[i].Attributes)
+				}
+			}
+			for _, span := range exporter.GetSpans() {
+				for _, kv := range span.Attributes {
+					if strings.Contains(kv.Value.Emit(), "secret_value") {
+						t.Errorf("span %s recorded the secret in %s", span.Name, kv.Key)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSecretTracingStages(t *testing.T) {
+	t.Parallel()
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	s := New("secret_ARN", WithTracerProvider(tp), WithRetrieveFunc(func(ctx context.Context, arn, stage string) (v sm.Value, err error) {
+		return sm.Value{SecretString: "secret_value", VersionID: "v0"}, nil
+	}))
+	for i := 0; i < 2; i++ {
+		if _, err := s.GetStage(context.Background(), sm.StagePrevious, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	var cacheHits []interface{}
+	for _, span := range tracedSpans(exporter) {
+		if span.Name != "store.GetStage" {
+			continue
+		}
+		if span.Attributes[attrStage] != sm.StagePrevious {
+			t.Errorf("expected the %s stage, got %v", sm.StagePrevious, span.Attributes[attrStage])
+		}
+		cacheHits = append(cacheHits, span.Attributes[attrCacheHit])
+	}
+	if expected := []interface{}{false, true}; !reflect.DeepEqual(expected, cacheHits) {
+		t.Errorf("expected cache hits %v, got %v", expected, cacheHits)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"io/ioutil"
+	"log/slog"
 	"os"
 	"time"
 
@@ -22,7 +23,7 @@ func (fcs fileCredentialStore) Get(force bool) (credential string, err error) {
 	if err != nil {
 		return
 	}
-	fmt.Printf("Credentials read: %v\n", string(bytes))
+	fmt.Println("Credentials read.")
 	return string(bytes), err
 }
 
@@ -35,7 +36,7 @@ func main() {
 	fcs := fileCredentialStore{
 		Filename: "credentials.txt",
 	}
-	c := connector.New(fcs)
+	c := connector.New(fcs, connector.WithLogger(slog.Default()))
 	db := sql.OpenDB(c)
 	err := db.Ping()
 	if err != nil {